@@ -0,0 +1,122 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cassette provides an http.RoundTripper that records
+// Mesh requests and responses to a cassette file and an
+// http.RoundTripper that replays them offline.
+//
+// A cassette is a JSONL file with one *Interaction per line.
+package cassette
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Interaction is a recorded request and response.
+type Interaction struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+
+	// Request is the JSON request body (if any).
+	Request json.RawMessage `json:"request,omitempty"`
+
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type,omitempty"`
+
+	// Response is the response body if it is valid JSON.
+	// Otherwise, the body is stored in RawResponse.
+	Response    json.RawMessage `json:"response,omitempty"`
+	RawResponse string          `json:"raw_response,omitempty"`
+}
+
+// responseBody returns the recorded response body.
+func (i *Interaction) responseBody() []byte {
+	if len(i.RawResponse) > 0 {
+		return []byte(i.RawResponse)
+	}
+
+	return i.Response
+}
+
+// setResponseBody records a response body.
+func (i *Interaction) setResponseBody(body []byte) {
+	if json.Valid(body) {
+		i.Response = body
+	} else {
+		i.RawResponse = string(body)
+	}
+}
+
+// newInteraction constructs an *Interaction (without
+// its response body) from a request and response. The
+// request body must be empty or valid JSON.
+func newInteraction(
+	method string,
+	path string,
+	requestBody []byte,
+	statusCode int,
+	contentType string,
+) *Interaction {
+	interaction := &Interaction{
+		Method:      method,
+		Path:        path,
+		StatusCode:  statusCode,
+		ContentType: contentType,
+	}
+
+	if len(requestBody) > 0 {
+		interaction.Request = requestBody
+	}
+
+	return interaction
+}
+
+// Load reads all *Interaction in a cassette file.
+func Load(path string) ([]*Interaction, error) {
+	file, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return nil, fmt.Errorf("unable to open cassette %s: %w", path, err)
+	}
+	defer file.Close()
+
+	return read(file)
+}
+
+func read(r io.Reader) ([]*Interaction, error) {
+	interactions := []*Interaction{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxInteractionSize)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var interaction Interaction
+		if err := json.Unmarshal(scanner.Bytes(), &interaction); err != nil {
+			return nil, fmt.Errorf("unable to unmarshal interaction %d: %w", len(interactions), err)
+		}
+
+		interactions = append(interactions, &interaction)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read cassette: %w", err)
+	}
+
+	return interactions, nil
+}
@@ -0,0 +1,266 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cassette
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/client"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+	"github.com/dominant-strategies/mesh-sdk-go/utils"
+)
+
+var (
+	network = &types.NetworkIdentifier{
+		Blockchain: "blockchain",
+		Network:    "network",
+	}
+
+	networkStatus = &types.NetworkStatusResponse{
+		CurrentBlockIdentifier: &types.BlockIdentifier{
+			Index: 10,
+			Hash:  "block 10",
+		},
+		CurrentBlockTimestamp: 1582833600000,
+		GenesisBlockIdentifier: &types.BlockIdentifier{
+			Index: 0,
+			Hash:  "block 0",
+		},
+	}
+)
+
+func newClient(address string, transport http.RoundTripper) *client.APIClient {
+	return client.NewAPIClient(client.NewConfiguration(
+		address,
+		"cassette-test",
+		&http.Client{Transport: transport},
+	))
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := utils.CreateTempDir()
+	assert.NoError(t, err)
+	defer utils.RemoveTempDir(dir)
+
+	cassettePath := path.Join(dir, "cassette.jsonl")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		switch r.URL.RequestURI() {
+		case "/network/status":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintln(w, types.PrettyPrintStruct(networkStatus))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintln(w, types.PrettyPrintStruct(&types.Error{
+				Code:    1,
+				Message: "not implemented",
+			}))
+		}
+	}))
+
+	recorder, err := NewRecorder(cassettePath, nil)
+	assert.NoError(t, err)
+
+	recordingClient := newClient(ts.URL, recorder)
+	status, _, err := recordingClient.NetworkAPI.NetworkStatus(ctx, &types.NetworkRequest{
+		NetworkIdentifier: network,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, networkStatus, status)
+
+	_, clientErr, err := recordingClient.NetworkAPI.NetworkOptions(ctx, &types.NetworkRequest{
+		NetworkIdentifier: network,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, "not implemented", clientErr.Message)
+
+	assert.NoError(t, recorder.Close())
+	ts.Close()
+
+	interactions, err := Load(cassettePath)
+	assert.NoError(t, err)
+	assert.Len(t, interactions, 2)
+	assert.Equal(t, "/network/status", interactions[0].Path)
+	assert.Equal(t, http.StatusOK, interactions[0].StatusCode)
+	assert.Equal(t, http.StatusInternalServerError, interactions[1].StatusCode)
+
+	var tests = map[string]struct {
+		matching Matching
+		request  *types.NetworkRequest
+
+		expectedError error
+	}{
+		"strict": {
+			matching: StrictMatching,
+			request: &types.NetworkRequest{
+				NetworkIdentifier: network,
+			},
+		},
+		"strict with extra field": {
+			matching: StrictMatching,
+			request: &types.NetworkRequest{
+				NetworkIdentifier: network,
+				Metadata:          map[string]interface{}{"extra": true},
+			},
+			expectedError: ErrInteractionNotFound,
+		},
+		"lenient with extra field": {
+			matching: LenientMatching,
+			request: &types.NetworkRequest{
+				NetworkIdentifier: network,
+				Metadata:          map[string]interface{}{"extra": true},
+			},
+		},
+		"lenient with different network": {
+			matching: LenientMatching,
+			request: &types.NetworkRequest{
+				NetworkIdentifier: &types.NetworkIdentifier{
+					Blockchain: "blockchain",
+					Network:    "other",
+				},
+			},
+			expectedError: ErrInteractionNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			replayer, err := NewReplayer(cassettePath, test.matching)
+			assert.NoError(t, err)
+
+			replayingClient := newClient(ts.URL, replayer)
+			status, _, err := replayingClient.NetworkAPI.NetworkStatus(ctx, test.request)
+			if test.expectedError != nil {
+				assert.ErrorIs(t, err, test.expectedError)
+				assert.Nil(t, status)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, networkStatus, status)
+			assert.Equal(t, interactions[1:], replayer.Unused())
+
+			// The last matching interaction is served again.
+			status, _, err = replayingClient.NetworkAPI.NetworkStatus(ctx, test.request)
+			assert.NoError(t, err)
+			assert.Equal(t, networkStatus, status)
+
+			_, clientErr, err := replayingClient.NetworkAPI.NetworkOptions(ctx, &types.NetworkRequest{
+				NetworkIdentifier: network,
+			})
+			assert.Error(t, err)
+			assert.Equal(t, "not implemented", clientErr.Message)
+			assert.Empty(t, replayer.Unused())
+		})
+	}
+}
+
+func TestRecordStreamingResponse(t *testing.T) {
+	dir, err := utils.CreateTempDir()
+	assert.NoError(t, err)
+	defer utils.RemoveTempDir(dir)
+
+	cassettePath := path.Join(dir, "cassette.jsonl")
+
+	next := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, `{"sequence":0}`)
+		w.(http.Flusher).Flush()
+
+		<-next
+		fmt.Fprintln(w, `{"sequence":1}`)
+	}))
+	defer ts.Close()
+
+	recorder, err := NewRecorder(cassettePath, nil)
+	assert.NoError(t, err)
+
+	req, err := http.NewRequest(
+		http.MethodPost,
+		ts.URL+"/events/blocks/stream",
+		strings.NewReader(`{"offset":0}`),
+	)
+	assert.NoError(t, err)
+
+	// The first line is read before the
+	// server writes the second.
+	resp, err := recorder.RoundTrip(req)
+	assert.NoError(t, err)
+	reader := bufio.NewReader(resp.Body)
+	line, err := reader.ReadString('\n')
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"sequence\":0}\n", line)
+
+	close(next)
+	line, err = reader.ReadString('\n')
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"sequence\":1}\n", line)
+	assert.NoError(t, resp.Body.Close())
+	assert.NoError(t, recorder.Close())
+
+	interactions, err := Load(cassettePath)
+	assert.NoError(t, err)
+	assert.Len(t, interactions, 1)
+	assert.Equal(t, "/events/blocks/stream", interactions[0].Path)
+	assert.Equal(t, "{\"sequence\":0}\n{\"sequence\":1}\n", interactions[0].RawResponse)
+}
+
+func TestRecordInvalidRequestBody(t *testing.T) {
+	dir, err := utils.CreateTempDir()
+	assert.NoError(t, err)
+	defer utils.RemoveTempDir(dir)
+
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	recorder, err := NewRecorder(path.Join(dir, "cassette.jsonl"), nil)
+	assert.NoError(t, err)
+	defer recorder.Close()
+
+	req, err := http.NewRequest(
+		http.MethodPost,
+		ts.URL+"/network/status",
+		strings.NewReader("not json"),
+	)
+	assert.NoError(t, err)
+
+	resp, err := recorder.RoundTrip(req)
+	assert.ErrorIs(t, err, ErrInvalidRequestBody)
+	assert.Nil(t, resp)
+	assert.Equal(t, 0, calls)
+}
+
+func TestNewReplayerInvalidMatching(t *testing.T) {
+	_, err := NewReplayerWithInteractions(nil, "fuzzy")
+	assert.ErrorIs(t, err, ErrInvalidMatching)
+}
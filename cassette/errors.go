@@ -0,0 +1,33 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cassette
+
+import (
+	"errors"
+)
+
+var (
+	// ErrInvalidRequestBody is returned when recording
+	// a request with a body that is not valid JSON.
+	ErrInvalidRequestBody = errors.New("request body is not valid JSON")
+
+	// ErrInteractionNotFound is returned when replaying
+	// a request that does not match any *Interaction.
+	ErrInteractionNotFound = errors.New("no matching interaction found")
+
+	// ErrInvalidMatching is returned when constructing
+	// a *Replayer with an unsupported Matching.
+	ErrInvalidMatching = errors.New("invalid matching")
+)
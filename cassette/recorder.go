@@ -0,0 +1,175 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+)
+
+const (
+	// maxInteractionSize is the largest line
+	// that can be read from a cassette.
+	maxInteractionSize = 64 * 1024 * 1024
+)
+
+// Recorder is an http.RoundTripper that appends each
+// request and response made with the underlying
+// transport to a cassette file. Response bodies are
+// recorded as the caller reads them (so streaming
+// responses are not delayed), and each interaction is
+// written once its body is read to EOF or closed.
+type Recorder struct {
+	transport http.RoundTripper
+
+	mutex sync.Mutex
+	file  *os.File
+}
+
+// NewRecorder creates (or truncates) a cassette file
+// and returns a *Recorder that writes to it. If transport
+// is nil, http.DefaultTransport is used.
+func NewRecorder(path string, transport http.RoundTripper) (*Recorder, error) {
+	file, err := os.Create(path) // #nosec G304
+	if err != nil {
+		return nil, fmt.Errorf("unable to create cassette %s: %w", path, err)
+	}
+
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &Recorder{
+		transport: transport,
+		file:      file,
+	}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil {
+		var err error
+		requestBody, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read request body: %w", err)
+		}
+
+		// Requests that cannot be recorded are
+		// never sent.
+		if len(requestBody) > 0 && !json.Valid(requestBody) {
+			return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, ErrInvalidRequestBody)
+		}
+
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = &recordingBody{
+		ReadCloser: resp.Body,
+		recorder:   r,
+		interaction: newInteraction(
+			req.Method,
+			req.URL.Path,
+			requestBody,
+			resp.StatusCode,
+			resp.Header.Get("Content-Type"),
+		),
+	}
+
+	return resp, nil
+}
+
+// recordingBody copies a response body as it is
+// read and writes its interaction to the cassette
+// at EOF (or when it is closed).
+type recordingBody struct {
+	io.ReadCloser
+
+	recorder    *Recorder
+	interaction *Interaction
+	body        bytes.Buffer
+	once        sync.Once
+	err         error
+}
+
+// Read implements io.Reader.
+func (b *recordingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.body.Write(p[:n])
+	if errors.Is(err, io.EOF) {
+		if recordErr := b.record(); recordErr != nil {
+			return n, recordErr
+		}
+	}
+
+	return n, err
+}
+
+// Close implements io.Closer.
+func (b *recordingBody) Close() error {
+	closeErr := b.ReadCloser.Close()
+	if err := b.record(); err != nil {
+		return err
+	}
+
+	return closeErr
+}
+
+// record writes the interaction (with the body
+// read so far) the first time it is called.
+func (b *recordingBody) record() error {
+	b.once.Do(func() {
+		b.interaction.setResponseBody(b.body.Bytes())
+		b.err = b.recorder.write(b.interaction)
+	})
+
+	return b.err
+}
+
+func (r *Recorder) write(interaction *Interaction) error {
+	line, err := json.Marshal(interaction)
+	if err != nil {
+		return fmt.Errorf("unable to marshal interaction: %w", err)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, err := r.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("unable to write interaction: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes the cassette file.
+func (r *Recorder) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.file.Close()
+}
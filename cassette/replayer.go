@@ -0,0 +1,237 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+)
+
+// Matching determines how a *Replayer compares request
+// bodies with recorded requests.
+type Matching string
+
+const (
+	// StrictMatching requires the request body to be
+	// equal to the recorded request (ignoring whitespace
+	// and the order of keys).
+	StrictMatching Matching = "strict"
+
+	// LenientMatching requires every field of the recorded
+	// request to be present in the request body with an equal
+	// value. Fields not present in the recorded request (like
+	// newly added metadata) are ignored.
+	LenientMatching Matching = "lenient"
+)
+
+// Replayer is an http.RoundTripper that serves recorded
+// responses without making any network requests.
+//
+// Each request is served by the first unused *Interaction
+// that matches it, so repeated requests (like retries) are
+// served in the order they were recorded. Once all matching
+// interactions are used, the last one is served again.
+type Replayer struct {
+	matching     Matching
+	interactions []*Interaction
+
+	mutex sync.Mutex
+	used  []bool
+}
+
+// NewReplayer loads a cassette file and returns
+// a *Replayer that serves its interactions.
+func NewReplayer(path string, matching Matching) (*Replayer, error) {
+	interactions, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReplayerWithInteractions(interactions, matching)
+}
+
+// NewReplayerWithInteractions returns a *Replayer
+// that serves the provided interactions.
+func NewReplayerWithInteractions(
+	interactions []*Interaction,
+	matching Matching,
+) (*Replayer, error) {
+	switch matching {
+	case StrictMatching, LenientMatching:
+	default:
+		return nil, fmt.Errorf("%s is not supported: %w", matching, ErrInvalidMatching)
+	}
+
+	return &Replayer{
+		matching:     matching,
+		interactions: interactions,
+		used:         make([]bool, len(interactions)),
+	}, nil
+}
+
+// decode parses a JSON body into a generic
+// value (nil if the body is empty).
+func decode(body []byte) (interface{}, error) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil, nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// subset returns a boolean indicating if all
+// fields in recorded are present in actual.
+func subset(recorded interface{}, actual interface{}) bool {
+	recordedMap, ok := recorded.(map[string]interface{})
+	if !ok {
+		return reflect.DeepEqual(recorded, actual)
+	}
+
+	actualMap, ok := actual.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	for key, value := range recordedMap {
+		actualValue, ok := actualMap[key]
+		if !ok || !subset(value, actualValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *Replayer) matches(
+	interaction *Interaction,
+	req *http.Request,
+	body interface{},
+) bool {
+	if interaction.Method != req.Method || interaction.Path != req.URL.Path {
+		return false
+	}
+
+	recorded, err := decode(interaction.Request)
+	if err != nil {
+		return false
+	}
+
+	if r.matching == LenientMatching {
+		return subset(recorded, body)
+	}
+
+	return reflect.DeepEqual(recorded, body)
+}
+
+// find returns the *Interaction that should serve a request.
+func (r *Replayer) find(req *http.Request, body interface{}) *Interaction {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	last := -1
+	for i, interaction := range r.interactions {
+		if !r.matches(interaction, req, body) {
+			continue
+		}
+
+		if !r.used[i] {
+			r.used[i] = true
+			return interaction
+		}
+
+		last = i
+	}
+
+	if last == -1 {
+		return nil
+	}
+
+	return r.interactions[last]
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil {
+		var err error
+		requestBody, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read request body: %w", err)
+		}
+	}
+
+	body, err := decode(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, ErrInvalidRequestBody)
+	}
+
+	interaction := r.find(req, body)
+	if interaction == nil {
+		return nil, fmt.Errorf(
+			"%s %s %s: %w",
+			req.Method,
+			req.URL.Path,
+			string(requestBody),
+			ErrInteractionNotFound,
+		)
+	}
+
+	responseBody := interaction.responseBody()
+	header := http.Header{}
+	if len(interaction.ContentType) > 0 {
+		header.Set("Content-Type", interaction.ContentType)
+	}
+	header.Set("Content-Length", strconv.Itoa(len(responseBody)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		StatusCode:    interaction.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(responseBody)),
+		ContentLength: int64(len(responseBody)),
+		Request:       req,
+	}, nil
+}
+
+// Unused returns the interactions that have not
+// been served by the *Replayer.
+func (r *Replayer) Unused() []*Interaction {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	unused := []*Interaction{}
+	for i, interaction := range r.interactions {
+		if !r.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+
+	return unused
+}
@@ -123,18 +123,17 @@ func (a *AccountAPIService) AccountBalance(
 	case _nethttp.StatusBadGateway,
 		_nethttp.StatusServiceUnavailable,
 		_nethttp.StatusGatewayTimeout,
-		_nethttp.StatusRequestTimeout:
+		_nethttp.StatusRequestTimeout,
+		_nethttp.StatusTooManyRequests:
 		return nil, nil, fmt.Errorf(
-			"status code %d, response body %s: %w",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"%w: %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 			ErrRetriable,
 		)
 	default:
 		return nil, nil, fmt.Errorf(
-			"invalid status code %d, response body %s",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"invalid %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 		)
 	}
 }
@@ -229,18 +228,17 @@ func (a *AccountAPIService) AccountCoins(
 	case _nethttp.StatusBadGateway,
 		_nethttp.StatusServiceUnavailable,
 		_nethttp.StatusGatewayTimeout,
-		_nethttp.StatusRequestTimeout:
+		_nethttp.StatusRequestTimeout,
+		_nethttp.StatusTooManyRequests:
 		return nil, nil, fmt.Errorf(
-			"status code %d, response body %s: %w",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"%w: %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 			ErrRetriable,
 		)
 	default:
 		return nil, nil, fmt.Errorf(
-			"invalid status code %d, response body %s",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"invalid %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 		)
 	}
 }
@@ -0,0 +1,105 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// BlockStream requests a block like Block but returns the response
+// body instead of decoding it, so that callers can decode the
+// transactions of large blocks incrementally. The caller must
+// close the returned io.ReadCloser.
+//
+// This file is not generated (see IGNORED_FILES in codegen.sh).
+func (a *BlockAPIService) BlockStream(
+	ctx context.Context,
+	blockRequest *types.BlockRequest,
+) (io.ReadCloser, *types.Error, error) {
+	return a.client.openStream(ctx, "/block", blockRequest, "application/json")
+}
+
+// openStream makes a request to path and returns the response body
+// (without reading it) if the request succeeds. Otherwise, the
+// response is handled like any other request.
+func (c *APIClient) openStream(
+	ctx context.Context,
+	path string,
+	request interface{},
+	accept string,
+) (io.ReadCloser, *types.Error, error) {
+	headerParams := map[string]string{
+		"Content-Type": "application/json",
+		"Accept":       accept,
+	}
+
+	r, err := c.prepareRequest(ctx, c.cfg.BasePath+path, request, headerParams)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to prepare request: %w", err)
+	}
+
+	response, err := c.callAPI(ctx, r)
+	if err != nil || response == nil {
+		return nil, nil, fmt.Errorf("failed to call API: %w", err)
+	}
+
+	if response.StatusCode == http.StatusOK {
+		return response.Body, nil, nil
+	}
+
+	body, err := io.ReadAll(response.Body)
+	defer func() {
+		_, _ = io.Copy(io.Discard, response.Body)
+		_ = response.Body.Close()
+	}()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	switch response.StatusCode {
+	case http.StatusInternalServerError:
+		var v types.Error
+		err = c.decode(&v, body, response.Header.Get("Content-Type"))
+		if err != nil {
+			return nil, nil, fmt.Errorf(
+				"failed to decode when hit status code 500, response body %s: %w",
+				string(body),
+				err,
+			)
+		}
+
+		return nil, &v, fmt.Errorf("error %+v", v)
+	case http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+		http.StatusRequestTimeout,
+		http.StatusTooManyRequests:
+		return nil, nil, fmt.Errorf(
+			"%w: %w",
+			&StatusError{StatusCode: response.StatusCode, Body: string(body)},
+			ErrRetriable,
+		)
+	default:
+		return nil, nil, fmt.Errorf(
+			"invalid %w",
+			&StatusError{StatusCode: response.StatusCode, Body: string(body)},
+		)
+	}
+}
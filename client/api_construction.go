@@ -115,18 +115,17 @@ func (a *ConstructionAPIService) ConstructionCombine(
 	case _nethttp.StatusBadGateway,
 		_nethttp.StatusServiceUnavailable,
 		_nethttp.StatusGatewayTimeout,
-		_nethttp.StatusRequestTimeout:
+		_nethttp.StatusRequestTimeout,
+		_nethttp.StatusTooManyRequests:
 		return nil, nil, fmt.Errorf(
-			"status code %d, response body %s: %w",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"%w: %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 			ErrRetriable,
 		)
 	default:
 		return nil, nil, fmt.Errorf(
-			"invalid status code %d, response body %s",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"invalid %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 		)
 	}
 }
@@ -212,18 +211,17 @@ func (a *ConstructionAPIService) ConstructionDerive(
 	case _nethttp.StatusBadGateway,
 		_nethttp.StatusServiceUnavailable,
 		_nethttp.StatusGatewayTimeout,
-		_nethttp.StatusRequestTimeout:
+		_nethttp.StatusRequestTimeout,
+		_nethttp.StatusTooManyRequests:
 		return nil, nil, fmt.Errorf(
-			"status code %d, response body %s: %w",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"%w: %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 			ErrRetriable,
 		)
 	default:
 		return nil, nil, fmt.Errorf(
-			"invalid status code %d, response body %s",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"invalid %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 		)
 	}
 }
@@ -309,18 +307,17 @@ func (a *ConstructionAPIService) ConstructionHash(
 	case _nethttp.StatusBadGateway,
 		_nethttp.StatusServiceUnavailable,
 		_nethttp.StatusGatewayTimeout,
-		_nethttp.StatusRequestTimeout:
+		_nethttp.StatusRequestTimeout,
+		_nethttp.StatusTooManyRequests:
 		return nil, nil, fmt.Errorf(
-			"status code %d, response body %s: %w",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"%w: %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 			ErrRetriable,
 		)
 	default:
 		return nil, nil, fmt.Errorf(
-			"invalid status code %d, response body %s",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"invalid %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 		)
 	}
 }
@@ -413,18 +410,17 @@ func (a *ConstructionAPIService) ConstructionMetadata(
 	case _nethttp.StatusBadGateway,
 		_nethttp.StatusServiceUnavailable,
 		_nethttp.StatusGatewayTimeout,
-		_nethttp.StatusRequestTimeout:
+		_nethttp.StatusRequestTimeout,
+		_nethttp.StatusTooManyRequests:
 		return nil, nil, fmt.Errorf(
-			"status code %d, response body %s: %w",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"%w: %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 			ErrRetriable,
 		)
 	default:
 		return nil, nil, fmt.Errorf(
-			"invalid status code %d, response body %s",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"invalid %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 		)
 	}
 }
@@ -511,18 +507,17 @@ func (a *ConstructionAPIService) ConstructionParse(
 	case _nethttp.StatusBadGateway,
 		_nethttp.StatusServiceUnavailable,
 		_nethttp.StatusGatewayTimeout,
-		_nethttp.StatusRequestTimeout:
+		_nethttp.StatusRequestTimeout,
+		_nethttp.StatusTooManyRequests:
 		return nil, nil, fmt.Errorf(
-			"status code %d, response body %s: %w",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"%w: %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 			ErrRetriable,
 		)
 	default:
 		return nil, nil, fmt.Errorf(
-			"invalid status code %d, response body %s",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"invalid %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 		)
 	}
 }
@@ -614,18 +609,17 @@ func (a *ConstructionAPIService) ConstructionPayloads(
 	case _nethttp.StatusBadGateway,
 		_nethttp.StatusServiceUnavailable,
 		_nethttp.StatusGatewayTimeout,
-		_nethttp.StatusRequestTimeout:
+		_nethttp.StatusRequestTimeout,
+		_nethttp.StatusTooManyRequests:
 		return nil, nil, fmt.Errorf(
-			"status code %d, response body %s: %w",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"%w: %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 			ErrRetriable,
 		)
 	default:
 		return nil, nil, fmt.Errorf(
-			"invalid status code %d, response body %s",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"invalid %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 		)
 	}
 }
@@ -715,18 +709,17 @@ func (a *ConstructionAPIService) ConstructionPreprocess(
 	case _nethttp.StatusBadGateway,
 		_nethttp.StatusServiceUnavailable,
 		_nethttp.StatusGatewayTimeout,
-		_nethttp.StatusRequestTimeout:
+		_nethttp.StatusRequestTimeout,
+		_nethttp.StatusTooManyRequests:
 		return nil, nil, fmt.Errorf(
-			"status code %d, response body %s: %w",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"%w: %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 			ErrRetriable,
 		)
 	default:
 		return nil, nil, fmt.Errorf(
-			"invalid status code %d, response body %s",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"invalid %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 		)
 	}
 }
@@ -815,18 +808,17 @@ func (a *ConstructionAPIService) ConstructionSubmit(
 	case _nethttp.StatusBadGateway,
 		_nethttp.StatusServiceUnavailable,
 		_nethttp.StatusGatewayTimeout,
-		_nethttp.StatusRequestTimeout:
+		_nethttp.StatusRequestTimeout,
+		_nethttp.StatusTooManyRequests:
 		return nil, nil, fmt.Errorf(
-			"status code %d, response body %s: %w",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"%w: %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 			ErrRetriable,
 		)
 	default:
 		return nil, nil, fmt.Errorf(
-			"invalid status code %d, response body %s",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"invalid %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 		)
 	}
 }
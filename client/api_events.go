@@ -118,18 +118,17 @@ func (a *EventsAPIService) EventsBlocks(
 	case _nethttp.StatusBadGateway,
 		_nethttp.StatusServiceUnavailable,
 		_nethttp.StatusGatewayTimeout,
-		_nethttp.StatusRequestTimeout:
+		_nethttp.StatusRequestTimeout,
+		_nethttp.StatusTooManyRequests:
 		return nil, nil, fmt.Errorf(
-			"status code %d, response body %s: %w",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"%w: %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 			ErrRetriable,
 		)
 	default:
 		return nil, nil, fmt.Errorf(
-			"invalid status code %d, response body %s",
-			localVarHTTPResponse.StatusCode,
-			string(localVarBody),
+			"invalid %w",
+			&StatusError{StatusCode: localVarHTTPResponse.StatusCode, Body: string(localVarBody)},
 		)
 	}
 }
@@ -0,0 +1,137 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// eventsStreamErrorEvent is the name of the server-sent
+// event that contains a *types.Error when a stream fails.
+const eventsStreamErrorEvent = "error"
+
+// BlockEventStream decodes *types.BlockEvents from
+// the server-sent events of /events/blocks/stream.
+type BlockEventStream struct {
+	body   io.ReadCloser
+	reader *bufio.Reader
+}
+
+// Next returns the next *types.BlockEvent in the stream. io.EOF
+// is returned when the server closes the stream. If the server
+// reports an error, it is returned as a *types.Error.
+func (s *BlockEventStream) Next() (*types.BlockEvent, *types.Error, error) {
+	var (
+		event string
+		data  strings.Builder
+	)
+
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			if err == io.EOF && len(line) == 0 {
+				return nil, nil, io.EOF
+			}
+
+			return nil, nil, fmt.Errorf("failed to read event: %w", err)
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		if len(line) > 0 {
+			field, value, _ := strings.Cut(line, ":")
+			value = strings.TrimPrefix(value, " ")
+			switch field {
+			case "event":
+				event = value
+			case "data":
+				if data.Len() > 0 {
+					data.WriteString("\n")
+				}
+				data.WriteString(value)
+			}
+
+			continue
+		}
+
+		// A blank line dispatches the event (events
+		// without data, like keepalives, are skipped).
+		if data.Len() == 0 {
+			event = ""
+			continue
+		}
+
+		if event == eventsStreamErrorEvent {
+			var v types.Error
+			if err := json.Unmarshal([]byte(data.String()), &v); err != nil {
+				return nil, nil, fmt.Errorf("failed to decode error %s: %w", data.String(), err)
+			}
+
+			return nil, &v, fmt.Errorf("error %+v", v)
+		}
+
+		var v types.BlockEvent
+		if err := json.Unmarshal([]byte(data.String()), &v); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode event %s: %w", data.String(), err)
+		}
+
+		return &v, nil, nil
+	}
+}
+
+// Close closes the stream.
+func (s *BlockEventStream) Close() error {
+	return s.body.Close()
+}
+
+// EventsBlocksStream subscribes to the *types.BlockEvents after
+// eventsBlocksRequest.Offset (as server-sent events). The timeout of
+// the configured http.Client is not applied to the stream (use ctx
+// to close it instead). The caller must close the returned
+// *BlockEventStream.
+//
+// This file is not generated (see IGNORED_FILES in codegen.sh).
+func (a *EventsAPIService) EventsBlocksStream(
+	ctx context.Context,
+	eventsBlocksRequest *types.EventsBlocksRequest,
+) (*BlockEventStream, *types.Error, error) {
+	httpClient := *a.client.cfg.HTTPClient
+	httpClient.Timeout = 0
+
+	cfg := *a.client.cfg
+	cfg.HTTPClient = &httpClient
+
+	streamClient := &APIClient{cfg: &cfg}
+	body, clientErr, err := streamClient.openStream(
+		ctx,
+		"/events/blocks/stream",
+		eventsBlocksRequest,
+		"text/event-stream",
+	)
+	if err != nil {
+		return nil, clientErr, err
+	}
+
+	return &BlockEventStream{
+		body:   body,
+		reader: bufio.NewReader(body),
+	}, nil, nil
+}
@@ -30,17 +30,38 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// tracerName is the name of the trace.Tracer used
+// to create spans for requests.
+const tracerName = "github.com/dominant-strategies/mesh-sdk-go/client"
+
 var (
 	jsonCheck = regexp.MustCompile(`(?i:(?:application|text)/(?:vnd\.[^;]+\+)?json)`)
 
-	// ErrRetriable is returned when a 502, 503, or 504 HTTP code is encountered.
+	// ErrRetriable is returned when a 408, 429, 502, 503, or 504 HTTP code is encountered.
 	// These status codes may be returned by intermediate services when a Rosetta
 	// implementation is overloaded and should not be considered failures.
 	ErrRetriable = errors.New("retriable http status code received")
 )
 
+// StatusError is returned (wrapped) when a request fails
+// with an HTTP status code that does not contain a types.Error.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+// Error returns the status code and body of the response.
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("status code %d, response body %s", e.StatusCode, e.Body)
+}
+
 // APIClient manages communication with the Rosetta API v1.4.12
 // In most cases there should be only one, shared, APIClient.
 type APIClient struct {
@@ -138,7 +159,29 @@ func (c *APIClient) callAPI(ctx context.Context, request *http.Request) (*http.R
 		log.Printf("\n%s\n", string(dump))
 	}
 
+	// Start a span for the request (if a TracerProvider
+	// is configured) and propagate it to the server using
+	// W3C trace context headers.
+	var span trace.Span
+	if c.cfg.TracerProvider != nil {
+		ctx, span = c.cfg.TracerProvider.Tracer(tracerName).Start(
+			ctx,
+			request.URL.Path,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("http.request.method", request.Method),
+				attribute.String("url.full", request.URL.String()),
+			),
+		)
+		defer span.End()
+
+		propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(request.Header))
+	}
+
 	resp, err := c.cfg.HTTPClient.Do(request.WithContext(ctx))
+	if span != nil {
+		traceResponse(span, resp, err)
+	}
 	if err != nil {
 		return resp, err
 	}
@@ -154,6 +197,20 @@ func (c *APIClient) callAPI(ctx context.Context, request *http.Request) (*http.R
 	return resp, nil
 }
 
+// traceResponse records the outcome of a request on span.
+func traceResponse(span trace.Span, resp *http.Response, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+}
+
 // ChangeBasePath changes base path to allow switching to mocks
 func (c *APIClient) ChangeBasePath(path string) {
 	c.cfg.BasePath = path
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 // contextKeys are used to identify the type of value in the context.
@@ -83,6 +85,11 @@ type Configuration struct {
 	Debug         bool              `json:"debug,omitempty"`
 	Servers       []ServerConfiguration
 	HTTPClient    *http.Client
+
+	// TracerProvider creates a span for each request (if
+	// populated). Span context is propagated to the server
+	// using W3C trace context headers.
+	TracerProvider trace.TracerProvider
 }
 
 // NewConfiguration returns a new Configuration object
@@ -31,21 +31,30 @@ esac
 
 # Remove existing client generated code
 mkdir -p tmp
-DIRS=(types client server)
-IGNORED_FILES=(README.md utils.go utils_test.go marshal_test.go account_currency.go account_coin.go)
+# Hand-written files are preserved in each directory
+TYPES_IGNORED_FILES=(README.md utils.go utils_test.go marshal_test.go account_currency.go account_coin.go)
+CLIENT_IGNORED_FILES=(README.md api_block_stream.go api_events_stream.go)
+SERVER_IGNORED_FILES=(README.md tracing.go options.go errors.go errors_test.go mode.go mode_test.go cache.go cache_test.go logging.go logging_test.go metrics.go metrics_test.go hardening.go hardening_test.go ratelimit.go ratelimit_test.go events_stream.go events_stream_test.go gateway.go gateway_test.go health.go health_test.go)
+
+clean_dir() {
+  local dir="$1"
+  shift
 
-for dir in "${DIRS[@]}"; do
   rm -rf tmp/*
-  for file in "${IGNORED_FILES[@]}"; do
+  for file in "$@"; do
     [ -f "${dir:?}"/"${file:?}" ] && mv "${dir:?}"/"${file:?}" tmp
   done
 
   rm -rf "${dir:?}"/*
 
-  for file in "${IGNORED_FILES[@]}"; do
+  for file in "$@"; do
     [ -f tmp/"${file:?}" ] && mv tmp/"${file:?}" "${dir:?}"/"${file:?}"
   done
-done
+}
+
+clean_dir types "${TYPES_IGNORED_FILES[@]}"
+clean_dir client "${CLIENT_IGNORED_FILES[@]}"
+clean_dir server "${SERVER_IGNORED_FILES[@]}"
 
 rm -rf tmp
 
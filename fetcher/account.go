@@ -43,7 +43,7 @@ func (f *Fetcher) AccountBalance(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	response, clientErr, err := f.rosettaClient.AccountAPI.AccountBalance(ctx,
+	response, clientErr, err := f.meshClient.AccountBalance(ctx,
 		&types.AccountBalanceRequest{
 			NetworkIdentifier: network,
 			AccountIdentifier: account,
@@ -79,21 +79,46 @@ func (f *Fetcher) AccountBalanceRetry(
 	account *types.AccountIdentifier,
 	block *types.PartialBlockIdentifier,
 	currencies []*types.Currency,
-) (*types.BlockIdentifier, []*types.Amount, map[string]interface{}, *Error) {
+) (_ *types.BlockIdentifier, _ []*types.Amount, _ map[string]interface{}, fetchErr *Error) {
+	ctx, span := f.startSpan(ctx, "AccountBalanceRetry")
+	defer func() { endSpan(span, fetchErr) }()
+
+	// Historical balances at a block hash are immutable,
+	// so they can be served from the cache.
+	var key string
+	if f.responseCache != nil && block != nil && block.Hash != nil {
+		key = cacheKey(accountBalanceCacheMethod, network, account, *block.Hash, currencies)
+
+		var cached types.AccountBalanceResponse
+		if f.responseCache.get(ctx, key, &cached) {
+			return cached.BlockIdentifier, cached.Balances, cached.Metadata, nil
+		}
+	}
+
 	backoffRetries := backoffRetries(
 		f.retryElapsedTime,
 		f.maxRetries,
 	)
 
 	for {
+		attemptCtx, attempt := f.startAttempt(ctx, "AccountBalanceRetry", backoffRetries)
 		responseBlock, balances, metadata, err := f.AccountBalance(
-			ctx,
+			attemptCtx,
 			network,
 			account,
 			block,
 			currencies,
 		)
+		endSpan(attempt, err)
 		if err == nil {
+			if len(key) > 0 {
+				f.responseCache.set(ctx, key, &types.AccountBalanceResponse{
+					BlockIdentifier: responseBlock,
+					Balances:        balances,
+					Metadata:        metadata,
+				})
+			}
+
 			return responseBlock, balances, metadata, nil
 		}
 
@@ -143,7 +168,7 @@ func (f *Fetcher) AccountCoins(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	response, clientErr, err := f.rosettaClient.AccountAPI.AccountCoins(ctx,
+	response, clientErr, err := f.meshClient.AccountCoins(ctx,
 		&types.AccountCoinsRequest{
 			NetworkIdentifier: network,
 			AccountIdentifier: account,
@@ -177,20 +202,25 @@ func (f *Fetcher) AccountCoinsRetry(
 	account *types.AccountIdentifier,
 	includeMempool bool,
 	currencies []*types.Currency,
-) (*types.BlockIdentifier, []*types.Coin, map[string]interface{}, *Error) {
+) (_ *types.BlockIdentifier, _ []*types.Coin, _ map[string]interface{}, fetchErr *Error) {
+	ctx, span := f.startSpan(ctx, "AccountCoinsRetry")
+	defer func() { endSpan(span, fetchErr) }()
+
 	backoffRetries := backoffRetries(
 		f.retryElapsedTime,
 		f.maxRetries,
 	)
 
 	for {
+		attemptCtx, attempt := f.startAttempt(ctx, "AccountCoinsRetry", backoffRetries)
 		responseBlock, coins, metadata, err := f.AccountCoins(
-			ctx,
+			attemptCtx,
 			network,
 			account,
 			includeMempool,
 			currencies,
 		)
+		endSpan(attempt, err)
 		if err == nil {
 			return responseBlock, coins, metadata, nil
 		}
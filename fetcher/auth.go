@@ -0,0 +1,154 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// TokenSource returns a bearer token to authenticate
+// requests. It is called before the first request and
+// again whenever a request is rejected with a 401.
+type TokenSource func(ctx context.Context) (string, error)
+
+// authTransport is an http.RoundTripper that adds
+// credentials to each request.
+type authTransport struct {
+	transport http.RoundTripper
+
+	basicAuth     bool
+	basicUsername string
+	basicPassword string
+
+	apiKeyHeader string
+	apiKey       string
+
+	bearerToken string
+	tokenSource TokenSource
+
+	tokenMutex sync.Mutex
+	token      string
+}
+
+// configured returns a boolean indicating if
+// any credentials were provided.
+func (a *authTransport) configured() bool {
+	return a.basicAuth ||
+		len(a.apiKeyHeader) > 0 ||
+		len(a.bearerToken) > 0 ||
+		a.tokenSource != nil
+}
+
+// currentToken returns the bearer token to use for a
+// request, fetching one from the TokenSource if needed.
+func (a *authTransport) currentToken(ctx context.Context) (string, error) {
+	if a.tokenSource == nil {
+		return a.bearerToken, nil
+	}
+
+	a.tokenMutex.Lock()
+	defer a.tokenMutex.Unlock()
+
+	if len(a.token) > 0 {
+		return a.token, nil
+	}
+
+	return a.fetchToken(ctx)
+}
+
+// refreshToken fetches a new token from the TokenSource
+// unless stale was already replaced by another request.
+func (a *authTransport) refreshToken(ctx context.Context, stale string) (string, error) {
+	a.tokenMutex.Lock()
+	defer a.tokenMutex.Unlock()
+
+	if a.token != stale {
+		return a.token, nil
+	}
+
+	return a.fetchToken(ctx)
+}
+
+// fetchToken must be called while holding tokenMutex.
+func (a *authTransport) fetchToken(ctx context.Context) (string, error) {
+	token, err := a.tokenSource(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to fetch bearer token: %w", err)
+	}
+
+	a.token = token
+	return token, nil
+}
+
+// authorize returns a copy of req with credentials added.
+func (a *authTransport) authorize(req *http.Request, token string) *http.Request {
+	req = req.Clone(req.Context())
+
+	if a.basicAuth {
+		req.SetBasicAuth(a.basicUsername, a.basicPassword)
+	}
+
+	// A bearer token takes precedence over basic auth
+	// (both use the Authorization header).
+	if len(token) > 0 {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	if len(a.apiKeyHeader) > 0 {
+		req.Header.Set(a.apiKeyHeader, a.apiKey)
+	}
+
+	return req
+}
+
+// RoundTrip adds credentials to req. If req is rejected
+// with a 401 and tokens are provided by a TokenSource, the
+// token is refreshed and req is attempted once more.
+func (a *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := a.currentToken(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.transport.RoundTrip(a.authorize(req, token))
+	if err != nil ||
+		resp.StatusCode != http.StatusUnauthorized ||
+		a.tokenSource == nil ||
+		(req.Body != nil && req.GetBody == nil) {
+		return resp, err
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	token, err = a.refreshToken(req.Context(), token)
+	if err != nil {
+		return nil, err
+	}
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		retry.Body, err = req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("unable to copy request body: %w", err)
+		}
+	}
+
+	return a.transport.RoundTrip(a.authorize(retry, token))
+}
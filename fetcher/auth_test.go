@@ -0,0 +1,249 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// newAuthServer returns a server that responds to /network/list
+// if authorized returns true for the request.
+func newAuthServer(
+	t *testing.T,
+	authorized func(r *http.Request) bool,
+	calls *int,
+) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+
+		// Ensure the body is provided on every attempt.
+		var request types.MetadataRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		if !authorized(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, types.PrettyPrintStruct(basicNetworkList))
+	}))
+	t.Cleanup(ts.Close)
+
+	return ts
+}
+
+func TestAuth(t *testing.T) {
+	var tests = map[string]struct {
+		options    []Option
+		authorized func(r *http.Request) bool
+
+		expectedCalls int
+		expectError   bool
+	}{
+		"basic auth": {
+			options: []Option{WithBasicAuth("user", "pass")},
+			authorized: func(r *http.Request) bool {
+				username, password, ok := r.BasicAuth()
+				return ok && username == "user" && password == "pass"
+			},
+			expectedCalls: 1,
+		},
+		"api key": {
+			options: []Option{WithAPIKey("X-API-Key", "secret")},
+			authorized: func(r *http.Request) bool {
+				return r.Header.Get("X-API-Key") == "secret"
+			},
+			expectedCalls: 1,
+		},
+		"static bearer token": {
+			options: []Option{WithBearerToken("token")},
+			authorized: func(r *http.Request) bool {
+				return r.Header.Get("Authorization") == "Bearer token"
+			},
+			expectedCalls: 1,
+		},
+		"bearer token overrides basic auth": {
+			options: []Option{WithBasicAuth("user", "pass"), WithBearerToken("token")},
+			authorized: func(r *http.Request) bool {
+				return r.Header.Get("Authorization") == "Bearer token"
+			},
+			expectedCalls: 1,
+		},
+		"rejected static bearer token": {
+			options: []Option{WithBearerToken("expired")},
+			authorized: func(r *http.Request) bool {
+				return r.Header.Get("Authorization") == "Bearer token"
+			},
+			expectedCalls: 1,
+			expectError:   true,
+		},
+		"no credentials": {
+			authorized: func(r *http.Request) bool {
+				return len(r.Header.Get("Authorization")) > 0
+			},
+			expectedCalls: 1,
+			expectError:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls int
+			ts := newAuthServer(t, test.authorized, &calls)
+
+			f := New(ts.URL, append(test.options, WithMaxRetries(0))...)
+			networkList, fetchErr := f.NetworkListRetry(context.Background(), nil)
+			if test.expectError {
+				assert.NotNil(t, fetchErr)
+			} else {
+				assert.Nil(t, fetchErr)
+				assert.Equal(t, basicNetworkList, networkList)
+			}
+			assert.Equal(t, test.expectedCalls, calls)
+		})
+	}
+}
+
+func TestTokenSource(t *testing.T) {
+	t.Run("refresh on 401", func(t *testing.T) {
+		var calls int
+		ts := newAuthServer(t, func(r *http.Request) bool {
+			return r.Header.Get("Authorization") == "Bearer token 2"
+		}, &calls)
+
+		var tokens int
+		f := New(ts.URL, WithTokenSource(func(ctx context.Context) (string, error) {
+			tokens++
+			return fmt.Sprintf("token %d", tokens), nil
+		}))
+
+		networkList, fetchErr := f.NetworkListRetry(context.Background(), nil)
+		assert.Nil(t, fetchErr)
+		assert.Equal(t, basicNetworkList, networkList)
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, 2, tokens)
+
+		// The refreshed token is reused.
+		_, fetchErr = f.NetworkListRetry(context.Background(), nil)
+		assert.Nil(t, fetchErr)
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, 2, tokens)
+	})
+
+	t.Run("token source error", func(t *testing.T) {
+		var calls int
+		ts := newAuthServer(t, func(r *http.Request) bool {
+			return true
+		}, &calls)
+
+		errTokenSource := errors.New("token source unavailable")
+		f := New(
+			ts.URL,
+			WithMaxRetries(0),
+			WithTokenSource(func(ctx context.Context) (string, error) {
+				return "", errTokenSource
+			}),
+		)
+
+		_, fetchErr := f.NetworkListRetry(context.Background(), nil)
+		assert.NotNil(t, fetchErr)
+		assert.Contains(t, fetchErr.Err.Error(), errTokenSource.Error())
+		assert.Equal(t, 0, calls)
+	})
+}
+
+// newClientCertificate creates a self-signed certificate
+// that can be used for client authentication.
+func newClientCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fetcher"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	leaf, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}
+}
+
+func TestClientCertificate(t *testing.T) {
+	certificate := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(certificate.Leaf)
+
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, types.PrettyPrintStruct(basicNetworkList))
+	}))
+	ts.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+	ts.StartTLS()
+	defer ts.Close()
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(ts.Certificate())
+
+	t.Run("with certificate", func(t *testing.T) {
+		f := New(ts.URL, WithRootCAs(rootCAs), WithClientCertificate(certificate))
+		networkList, fetchErr := f.NetworkListRetry(context.Background(), nil)
+		assert.Nil(t, fetchErr)
+		assert.Equal(t, basicNetworkList, networkList)
+	})
+
+	t.Run("without certificate", func(t *testing.T) {
+		f := New(ts.URL, WithRootCAs(rootCAs), WithMaxRetries(0))
+		_, fetchErr := f.NetworkListRetry(context.Background(), nil)
+		assert.NotNil(t, fetchErr)
+	})
+}
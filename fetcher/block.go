@@ -37,6 +37,11 @@ const (
 	// minRoutines is the minimum number of goroutines we should create
 	// to fetch block transactions.
 	minRoutines = 1
+
+	// streamWindow is the maximum number of other_transactions
+	// that StreamBlock fetches before their predecessors have been
+	// handled (which bounds the transactions it holds in memory).
+	streamWindow = 2 * maxRoutines
 )
 
 // FetchedTransaction is a structure that represents a transaction fetched
@@ -50,14 +55,24 @@ type FetchedTransaction struct {
 // addTransactionIdentifiers appends a slice of
 // types.TransactionIdentifiers to a channel.
 // When all types.TransactionIdentifiers are added,
-// the channel is closed.
+// the channel is closed. If window is not nil, a slot
+// is acquired in window before each identifier is added.
 func addTransactionIdentifiers(
 	ctx context.Context,
 	txsToFetch chan *types.TransactionIdentifier,
 	identifiers []*types.TransactionIdentifier,
+	window chan struct{},
 ) error {
 	defer close(txsToFetch)
 	for _, txHash := range identifiers {
+		if window != nil {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
 		select {
 		case txsToFetch <- txHash:
 		case <-ctx.Done():
@@ -95,11 +110,26 @@ func (f *Fetcher) fetchChannelTransactions(
 			f.maxRetries,
 		)
 
+		var key string
+		if f.responseCache != nil {
+			key = cacheKey(
+				blockTransactionCacheMethod,
+				network,
+				block.Hash,
+				transactionIdentifier.Hash,
+			)
+		}
+
 		var tx *types.BlockTransactionResponse
-		for {
+		var cached types.BlockTransactionResponse
+		if len(key) > 0 && f.responseCache.get(ctx, key, &cached) {
+			tx = &cached
+		}
+
+		for tx == nil {
 			var clientErr *types.Error
 			var err error
-			tx, clientErr, err = f.rosettaClient.BlockAPI.BlockTransaction(ctx,
+			tx, clientErr, err = f.meshClient.BlockTransaction(ctx,
 				&types.BlockTransactionRequest{
 					NetworkIdentifier:     network,
 					BlockIdentifier:       block,
@@ -107,6 +137,10 @@ func (f *Fetcher) fetchChannelTransactions(
 				},
 			)
 			if err == nil {
+				if len(key) > 0 {
+					f.responseCache.set(ctx, key, tx)
+				}
+
 				break
 			}
 
@@ -143,28 +177,35 @@ func (f *Fetcher) fetchChannelTransactions(
 	return nil
 }
 
-// UnsafeTransactions returns the unvalidated response
-// from the BlockTransaction method. UnsafeTransactions
-// fetches all provided types.TransactionIdentifiers
-// concurrently (with the number of threads specified
-// by txConcurrency). If any fetch fails, this function
-// will return an error.
-func (f *Fetcher) UnsafeTransactions(
+// streamTransactions fetches all provided types.TransactionIdentifiers
+// concurrently and calls handler with each *FetchedTransaction as
+// it arrives (in no particular order). handler is always invoked
+// from the calling goroutine. If any fetch fails or handler returns
+// an error, all outstanding fetches are cancelled.
+//
+// If window is not nil, no more than cap(window) transactions are
+// fetched until handler releases a slot (by receiving from window).
+func (f *Fetcher) streamTransactions(
 	ctx context.Context,
 	network *types.NetworkIdentifier,
 	block *types.BlockIdentifier,
 	transactionIdentifiers []*types.TransactionIdentifier,
-) ([]*types.Transaction, *Error) {
+	window chan struct{},
+	handler func(*FetchedTransaction) error,
+) *Error {
 	if len(transactionIdentifiers) == 0 {
-		return nil, nil
+		return nil
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	txsToFetch := make(chan *types.TransactionIdentifier)
 	fetchedTxs := make(chan *FetchedTransaction)
 	var fetchErr *Error
 	g, ctx := errgroup.WithContext(ctx)
 	g.Go(func() error {
-		return addTransactionIdentifiers(ctx, txsToFetch, transactionIdentifiers)
+		return addTransactionIdentifiers(ctx, txsToFetch, transactionIdentifiers, window)
 	})
 
 	// Calculate the concurrency we wish to use to fetch transactions. If we pick
@@ -199,14 +240,23 @@ func (f *Fetcher) UnsafeTransactions(
 		close(fetchedTxs)
 	}()
 
-	fetchedTxMap := make(map[string]*types.Transaction)
+	// We continue to drain fetchedTxs after handler
+	// returns an error so that no goroutine is left
+	// blocked on a send.
+	var handlerErr error
 	for fetchedTx := range fetchedTxs {
-		fetchedTxMap[fetchedTx.TxIdentifier.Hash] = fetchedTx.Tx
+		if handlerErr != nil {
+			continue
+		}
+
+		if err := handler(fetchedTx); err != nil {
+			handlerErr = err
+			cancel()
+		}
 	}
 
-	txs := make([]*types.Transaction, len(transactionIdentifiers))
-	for i, txID := range transactionIdentifiers {
-		txs[i] = fetchedTxMap[txID.Hash]
+	if handlerErr != nil {
+		return &Error{Err: handlerErr}
 	}
 
 	if err := g.Wait(); err != nil {
@@ -214,10 +264,49 @@ func (f *Fetcher) UnsafeTransactions(
 		// should be returned over whatever error
 		// is returned to the errGroup.
 		if fetchErr != nil {
-			return nil, fetchErr
+			return fetchErr
 		}
 
-		return nil, &Error{Err: err}
+		return &Error{Err: err}
+	}
+
+	return nil
+}
+
+// UnsafeTransactions returns the unvalidated response
+// from the BlockTransaction method. UnsafeTransactions
+// fetches all provided types.TransactionIdentifiers
+// concurrently (with the number of threads specified
+// by txConcurrency). If any fetch fails, this function
+// will return an error.
+func (f *Fetcher) UnsafeTransactions(
+	ctx context.Context,
+	network *types.NetworkIdentifier,
+	block *types.BlockIdentifier,
+	transactionIdentifiers []*types.TransactionIdentifier,
+) ([]*types.Transaction, *Error) {
+	if len(transactionIdentifiers) == 0 {
+		return nil, nil
+	}
+
+	fetchedTxMap := make(map[string]*types.Transaction)
+	if err := f.streamTransactions(
+		ctx,
+		network,
+		block,
+		transactionIdentifiers,
+		nil,
+		func(fetchedTx *FetchedTransaction) error {
+			fetchedTxMap[fetchedTx.TxIdentifier.Hash] = fetchedTx.Tx
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	txs := make([]*types.Transaction, len(transactionIdentifiers))
+	for i, txID := range transactionIdentifiers {
+		txs[i] = fetchedTxMap[txID.Hash]
 	}
 
 	return txs, nil
@@ -242,7 +331,7 @@ func (f *Fetcher) UnsafeBlock(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	blockResponse, clientErr, err := f.rosettaClient.BlockAPI.Block(ctx, &types.BlockRequest{
+	blockResponse, clientErr, err := f.meshClient.Block(ctx, &types.BlockRequest{
 		NetworkIdentifier: network,
 		BlockIdentifier:   blockIdentifier,
 	})
@@ -312,23 +401,44 @@ func (f *Fetcher) BlockRetry(
 	ctx context.Context,
 	network *types.NetworkIdentifier,
 	blockIdentifier *types.PartialBlockIdentifier,
-) (*types.Block, *Error) {
+) (_ *types.Block, fetchErr *Error) {
+	ctx, span := f.startSpan(ctx, "BlockRetry")
+	defer func() { endSpan(span, fetchErr) }()
+
 	if err := asserter.PartialBlockIdentifier(blockIdentifier); err != nil {
 		return nil, &Error{Err: err}
 	}
 
+	// Blocks requested by hash are immutable, so
+	// they can be served from the cache.
+	var key string
+	if f.responseCache != nil && blockIdentifier.Hash != nil {
+		key = cacheKey(blockCacheMethod, network, *blockIdentifier.Hash)
+
+		var cached types.Block
+		if f.responseCache.get(ctx, key, &cached) {
+			return &cached, nil
+		}
+	}
+
 	backoffRetries := backoffRetries(
 		f.retryElapsedTime,
 		f.maxRetries,
 	)
 
 	for {
+		attemptCtx, attempt := f.startAttempt(ctx, "BlockRetry", backoffRetries)
 		block, err := f.Block(
-			ctx,
+			attemptCtx,
 			network,
 			blockIdentifier,
 		)
+		endSpan(attempt, err)
 		if err == nil {
+			if len(key) > 0 && block != nil {
+				f.responseCache.set(ctx, key, block)
+			}
+
 			return block, nil
 		}
 
@@ -0,0 +1,100 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dominant-strategies/mesh-sdk-go/internal/cache"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+const (
+	// DefaultCacheSize is the default number of responses
+	// held in memory by a *ResponseCache.
+	DefaultCacheSize = cache.DefaultSize
+
+	blockCacheMethod            = "block"
+	blockTransactionCacheMethod = "block_transaction"
+	accountBalanceCacheMethod   = "account_balance"
+)
+
+// CacheBackend persists responses cached by a *ResponseCache
+// so that they survive restarts. A database.Database-backed
+// implementation is provided by storage/modules.
+type CacheBackend = cache.Backend
+
+// CacheStats are the counters of a *ResponseCache.
+// BackendErrors are not returned to callers of the
+// Fetcher.
+type CacheStats = cache.Stats
+
+// ResponseCache is an LRU cache of immutable responses (optionally
+// persisted to a CacheBackend). Responses are keyed by the hash
+// of the network and identifiers used to request them.
+//
+// Responses are stored as JSON so that callers can't modify
+// cached values.
+type ResponseCache struct {
+	lru *cache.LRU
+}
+
+// NewResponseCache constructs a new *ResponseCache that holds
+// up to size responses in memory. If size is <= 0,
+// DefaultCacheSize is used. The backend is optional.
+func NewResponseCache(size int, backend CacheBackend) *ResponseCache {
+	return &ResponseCache{
+		lru: cache.NewLRU(size, backend),
+	}
+}
+
+// Stats returns a copy of the current *CacheStats.
+func (c *ResponseCache) Stats() *CacheStats {
+	return c.lru.Stats()
+}
+
+// cacheKey returns the content-addressed key
+// of a request.
+func cacheKey(method string, network *types.NetworkIdentifier, identifiers ...interface{}) string {
+	return fmt.Sprintf("%s/%s", method, types.Hash(append(
+		[]interface{}{network},
+		identifiers...,
+	)))
+}
+
+// get populates v with the value stored for key
+// and returns a boolean indicating if it was found.
+func (c *ResponseCache) get(ctx context.Context, key string, v interface{}) bool {
+	value, ok := c.lru.Get(ctx, key, true)
+	if !ok {
+		return false
+	}
+
+	return json.Unmarshal(value, v) == nil
+}
+
+// set stores v for key in memory and
+// in the CacheBackend (if provided).
+func (c *ResponseCache) set(ctx context.Context, key string, v interface{}) {
+	value, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+
+	c.lru.Set(ctx, key, value, time.Time{})
+}
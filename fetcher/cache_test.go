@@ -0,0 +1,218 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+type mapCacheBackend struct {
+	mutex  sync.Mutex
+	values map[string][]byte
+}
+
+func (m *mapCacheBackend) Get(ctx context.Context, key string) (bool, []byte, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	value, ok := m.values[key]
+	return ok, value, nil
+}
+
+func (m *mapCacheBackend) Set(ctx context.Context, key string, value []byte) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.values[key] = value
+	return nil
+}
+
+func TestResponseCacheEviction(t *testing.T) {
+	var (
+		assert = assert.New(t)
+		ctx    = context.Background()
+		c      = NewResponseCache(2, nil)
+		value  string
+	)
+
+	c.set(ctx, "a", "1")
+	c.set(ctx, "b", "2")
+	assert.True(c.get(ctx, "a", &value))
+	assert.Equal("1", value)
+
+	// "b" is the least recently used key.
+	c.set(ctx, "c", "3")
+	assert.False(c.get(ctx, "b", &value))
+	assert.True(c.get(ctx, "c", &value))
+	assert.Equal("3", value)
+
+	assert.Equal(&CacheStats{
+		Hits:      2,
+		Misses:    1,
+		Evictions: 1,
+		Size:      2,
+	}, c.Stats())
+}
+
+func TestWithResponseCache(t *testing.T) {
+	var (
+		assert     = assert.New(t)
+		ctx        = context.Background()
+		mutex      sync.Mutex
+		blockCalls = 0
+		txCalls    = 0
+		balCalls   = 0
+		backend    = &mapCacheBackend{values: map[string][]byte{}}
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.WriteHeader(http.StatusOK)
+
+		switch r.URL.RequestURI() {
+		case "/block":
+			blockCalls++
+			fmt.Fprintln(w, types.PrettyPrintStruct(&types.BlockResponse{
+				Block:             basicFullBlock,
+				OtherTransactions: otherTransactions,
+			}))
+		case "/block/transaction":
+			txCalls++
+			fmt.Fprintln(w, types.PrettyPrintStruct(&types.BlockTransactionResponse{
+				Transaction: basicTransaction,
+			}))
+		case "/account/balance":
+			balCalls++
+			var request *types.AccountBalanceRequest
+			assert.NoError(json.NewDecoder(r.Body).Decode(&request))
+			fmt.Fprintln(w, types.PrettyPrintStruct(&types.AccountBalanceResponse{
+				BlockIdentifier: basicBlock,
+				Balances:        basicAmounts,
+			}))
+		}
+	}))
+	defer ts.Close()
+
+	a, err := asserter.NewClientWithOptions(
+		basicNetwork,
+		&types.BlockIdentifier{
+			Index: 0,
+			Hash:  "block 0",
+		},
+		basicNetworkOptions.Allow.OperationTypes,
+		basicNetworkOptions.Allow.OperationStatuses,
+		nil,
+		nil,
+		&asserter.Validations{
+			Enabled: false,
+		},
+	)
+	assert.NoError(err)
+
+	cache := NewResponseCache(10, backend)
+	f := New(
+		ts.URL,
+		WithRetryElapsedTime(5*time.Second),
+		WithAsserter(a),
+		WithResponseCache(cache),
+	)
+
+	// Blocks requested by hash are cached.
+	for i := 0; i < 2; i++ {
+		block, fetchErr := f.BlockRetry(
+			ctx,
+			basicNetwork,
+			&types.PartialBlockIdentifier{Hash: &basicBlock.Hash},
+		)
+		assert.Nil(fetchErr)
+		assert.Equal(basicBlockWithTransactions, block)
+	}
+	assert.Equal(1, blockCalls)
+	assert.Equal(1, txCalls)
+
+	// Blocks requested by index are not cached, but
+	// their transactions are.
+	block, fetchErr := f.BlockRetry(
+		ctx,
+		basicNetwork,
+		&types.PartialBlockIdentifier{Index: &basicBlock.Index},
+	)
+	assert.Nil(fetchErr)
+	assert.Equal(basicBlockWithTransactions, block)
+	assert.Equal(2, blockCalls)
+	assert.Equal(1, txCalls)
+
+	// Only historical balances at a block hash are cached.
+	for i := 0; i < 2; i++ {
+		responseBlock, balances, _, fetchErr := f.AccountBalanceRetry(
+			ctx,
+			basicNetwork,
+			basicAccount,
+			&types.PartialBlockIdentifier{Hash: &basicBlock.Hash},
+			nil,
+		)
+		assert.Nil(fetchErr)
+		assert.Equal(basicBlock, responseBlock)
+		assert.Equal(basicAmounts, balances)
+	}
+	assert.Equal(1, balCalls)
+
+	_, _, _, fetchErr = f.AccountBalanceRetry(ctx, basicNetwork, basicAccount, nil, nil)
+	assert.Nil(fetchErr)
+	assert.Equal(2, balCalls)
+
+	assert.Equal(&CacheStats{
+		Hits:   3,
+		Misses: 3,
+		Size:   3,
+	}, cache.Stats())
+
+	// Cached responses are loaded from the
+	// backend by a new cache.
+	otherCache := NewResponseCache(10, backend)
+	otherFetcher := New(
+		ts.URL,
+		WithAsserter(a),
+		WithResponseCache(otherCache),
+	)
+	block, fetchErr = otherFetcher.BlockRetry(
+		ctx,
+		basicNetwork,
+		&types.PartialBlockIdentifier{Hash: &basicBlock.Hash},
+	)
+	assert.Nil(fetchErr)
+	assert.Equal(basicBlockWithTransactions, block)
+	assert.Equal(2, blockCalls)
+	assert.Equal(&CacheStats{
+		Hits:        1,
+		BackendHits: 1,
+		Size:        1,
+	}, otherCache.Stats())
+}
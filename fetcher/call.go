@@ -37,7 +37,7 @@ func (f *Fetcher) Call(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	response, clientErr, err := f.rosettaClient.CallAPI.Call(
+	response, clientErr, err := f.meshClient.Call(
 		ctx,
 		&types.CallRequest{
 			NetworkIdentifier: network,
@@ -59,19 +59,24 @@ func (f *Fetcher) CallRetry(
 	network *types.NetworkIdentifier,
 	method string,
 	parameters map[string]interface{},
-) (map[string]interface{}, bool, *Error) {
+) (_ map[string]interface{}, _ bool, fetchErr *Error) {
+	ctx, span := f.startSpan(ctx, "CallRetry")
+	defer func() { endSpan(span, fetchErr) }()
+
 	backoffRetries := backoffRetries(
 		f.retryElapsedTime,
 		f.maxRetries,
 	)
 
 	for {
+		attemptCtx, attempt := f.startAttempt(ctx, "CallRetry", backoffRetries)
 		result, idempotent, err := f.Call(
-			ctx,
+			attemptCtx,
 			network,
 			method,
 			parameters,
 		)
+		endSpan(attempt, err)
 		if err == nil {
 			return result, idempotent, nil
 		}
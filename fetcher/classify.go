@@ -0,0 +1,154 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/dominant-strategies/mesh-sdk-go/client"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// ErrorCodes declares which of the error codes in
+// /network/options indicate a particular class of failure.
+// There is no standard for these codes, so they must be
+// provided for each implementation with WithErrorCodes.
+type ErrorCodes struct {
+	// NotFound codes are returned when the requested
+	// block, transaction, or account does not exist.
+	NotFound []int32
+
+	// NodeBehind codes are returned when the node has
+	// not yet synced the requested data.
+	NodeBehind []int32
+
+	// RateLimited codes are returned when the node
+	// rejects a request because of a rate limit.
+	RateLimited []int32
+}
+
+// classify returns the sentinel error (ErrNotFound, ErrNodeBehind,
+// or ErrRateLimited) associated with the code of rosettaErr, if any.
+func (c *ErrorCodes) classify(rosettaErr *types.Error) error {
+	if c == nil || rosettaErr == nil {
+		return nil
+	}
+
+	for _, class := range []struct {
+		codes []int32
+		err   error
+	}{
+		{c.NotFound, ErrNotFound},
+		{c.NodeBehind, ErrNodeBehind},
+		{c.RateLimited, ErrRateLimited},
+	} {
+		for _, code := range class.codes {
+			if code == rosettaErr.Code {
+				return class.err
+			}
+		}
+	}
+
+	return nil
+}
+
+// declared ensures all codes are declared in networkOptions.
+func (c *ErrorCodes) declared(networkOptions *types.NetworkOptionsResponse) error {
+	if c == nil {
+		return nil
+	}
+
+	declaredCodes := map[int32]struct{}{}
+	if networkOptions.Allow != nil {
+		for _, rosettaErr := range networkOptions.Allow.Errors {
+			declaredCodes[rosettaErr.Code] = struct{}{}
+		}
+	}
+
+	for _, codes := range [][]int32{c.NotFound, c.NodeBehind, c.RateLimited} {
+		for _, code := range codes {
+			if _, ok := declaredCodes[code]; !ok {
+				return fmt.Errorf("code %d: %w", code, ErrUndeclaredErrorCode)
+			}
+		}
+	}
+
+	return nil
+}
+
+// statusCode returns the HTTP status code of the
+// response that caused err (0 if there was none).
+func statusCode(err *Error) int {
+	var statusErr *client.StatusError
+	if errors.As(err.Err, &statusErr) {
+		return statusErr.StatusCode
+	}
+
+	return 0
+}
+
+// IsRetryable returns a boolean indicating if the request that
+// returned err may succeed if it is attempted again. This is the
+// case when the server returned a retriable *types.Error, the request
+// failed with a retriable HTTP status code (408, 429, 502, 503, or 504)
+// or a transient error (like a connection reset), or the node is
+// rate limiting requests or behind.
+func IsRetryable(err *Error) bool {
+	if err == nil || err.Err == nil || errors.Is(err.Err, context.Canceled) {
+		return false
+	}
+
+	if err.Retry || (err.ClientErr != nil && err.ClientErr.Retriable) {
+		return true
+	}
+
+	return transientError(err.Err) || IsNodeBehind(err) || IsRateLimited(err)
+}
+
+// IsNotFound returns a boolean indicating if the request that
+// returned err failed because the requested data does not exist
+// (a 404 or a code in ErrorCodes.NotFound).
+func IsNotFound(err *Error) bool {
+	if err == nil || err.Err == nil {
+		return false
+	}
+
+	return statusCode(err) == http.StatusNotFound || errors.Is(err.Err, ErrNotFound)
+}
+
+// IsNodeBehind returns a boolean indicating if the request that
+// returned err failed because the node has not yet synced the
+// requested data (a code in ErrorCodes.NodeBehind).
+func IsNodeBehind(err *Error) bool {
+	if err == nil || err.Err == nil {
+		return false
+	}
+
+	return errors.Is(err.Err, ErrNodeBehind)
+}
+
+// IsRateLimited returns a boolean indicating if the request that
+// returned err was rejected because of a rate limit (a 429 or a
+// code in ErrorCodes.RateLimited).
+func IsRateLimited(err *Error) bool {
+	if err == nil || err.Err == nil {
+		return false
+	}
+
+	return statusCode(err) == http.StatusTooManyRequests || errors.Is(err.Err, ErrRateLimited)
+}
@@ -0,0 +1,200 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+var (
+	classifiedErrorCodes = &ErrorCodes{
+		NotFound:    []int32{2},
+		NodeBehind:  []int32{3},
+		RateLimited: []int32{4},
+	}
+
+	classifiedNetworkOptions = &types.NetworkOptionsResponse{
+		Version: basicNetworkOptions.Version,
+		Allow: &types.Allow{
+			OperationStatuses: basicNetworkOptions.Allow.OperationStatuses,
+			OperationTypes:    basicNetworkOptions.Allow.OperationTypes,
+			Errors: []*types.Error{
+				{Code: 1, Message: "unavailable", Retriable: true},
+				{Code: 2, Message: "block not found"},
+				{Code: 3, Message: "node syncing", Retriable: true},
+				{Code: 4, Message: "too many requests", Retriable: true},
+				{Code: 5, Message: "invalid request"},
+			},
+		},
+	}
+)
+
+func TestClassifyError(t *testing.T) {
+	var tests = map[string]struct {
+		status    int
+		clientErr *types.Error
+		retries   uint64
+
+		retryable   bool
+		notFound    bool
+		nodeBehind  bool
+		rateLimited bool
+	}{
+		"retriable error": {
+			status:    http.StatusInternalServerError,
+			clientErr: classifiedNetworkOptions.Allow.Errors[0],
+			retryable: true,
+		},
+		"not found code": {
+			status:    http.StatusInternalServerError,
+			clientErr: classifiedNetworkOptions.Allow.Errors[1],
+			notFound:  true,
+		},
+		"node behind code": {
+			status:     http.StatusInternalServerError,
+			clientErr:  classifiedNetworkOptions.Allow.Errors[2],
+			retryable:  true,
+			nodeBehind: true,
+		},
+		"rate limited code": {
+			status:      http.StatusInternalServerError,
+			clientErr:   classifiedNetworkOptions.Allow.Errors[3],
+			retryable:   true,
+			rateLimited: true,
+		},
+		"non-retriable error": {
+			status:    http.StatusInternalServerError,
+			clientErr: classifiedNetworkOptions.Allow.Errors[4],
+		},
+		"404": {
+			status:   http.StatusNotFound,
+			notFound: true,
+		},
+		"429": {
+			status:      http.StatusTooManyRequests,
+			retryable:   true,
+			rateLimited: true,
+		},
+		"503": {
+			status:    http.StatusServiceUnavailable,
+			retryable: true,
+		},
+		"400": {
+			status: http.StatusBadRequest,
+		},
+		"exhausted retries": {
+			status:      http.StatusTooManyRequests,
+			retries:     1,
+			retryable:   true,
+			rateLimited: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+				w.WriteHeader(test.status)
+				if test.clientErr != nil {
+					fmt.Fprintln(w, types.PrettyPrintStruct(test.clientErr))
+				}
+			}))
+			defer ts.Close()
+
+			f := New(
+				ts.URL,
+				WithErrorCodes(classifiedErrorCodes),
+				WithMaxRetries(test.retries),
+				WithRetryElapsedTime(5*time.Second),
+			)
+
+			var fetchErr *Error
+			if test.retries > 0 {
+				_, fetchErr = f.NetworkListRetry(context.Background(), nil)
+				assert.True(errors.Is(fetchErr.Err, ErrExhaustedRetries))
+			} else {
+				_, fetchErr = f.NetworkList(context.Background(), nil)
+			}
+
+			assert.NotNil(fetchErr)
+			assert.Equal(test.retryable, IsRetryable(fetchErr))
+			assert.Equal(test.notFound, IsNotFound(fetchErr))
+			assert.Equal(test.nodeBehind, IsNodeBehind(fetchErr))
+			assert.Equal(test.rateLimited, IsRateLimited(fetchErr))
+		})
+	}
+
+	t.Run("nil error", func(t *testing.T) {
+		assert.False(t, IsRetryable(nil))
+		assert.False(t, IsNotFound(nil))
+		assert.False(t, IsNodeBehind(nil))
+		assert.False(t, IsRateLimited(nil))
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		assert.False(t, IsRetryable(&Error{Err: context.Canceled, Retry: true}))
+	})
+}
+
+func TestErrorCodesDeclared(t *testing.T) {
+	var tests = map[string]struct {
+		codes         *ErrorCodes
+		expectedError error
+	}{
+		"no codes": {},
+		"declared codes": {
+			codes: classifiedErrorCodes,
+		},
+		"undeclared code": {
+			codes:         &ErrorCodes{NodeBehind: []int32{3, 10}},
+			expectedError: ErrUndeclaredErrorCode,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+				w.WriteHeader(http.StatusOK)
+
+				switch r.URL.RequestURI() {
+				case "/network/list":
+					fmt.Fprintln(w, types.PrettyPrintStruct(basicNetworkList))
+				case "/network/status":
+					fmt.Fprintln(w, types.PrettyPrintStruct(basicNetworkStatus))
+				case "/network/options":
+					fmt.Fprintln(w, types.PrettyPrintStruct(classifiedNetworkOptions))
+				}
+			}))
+			defer ts.Close()
+
+			f := New(ts.URL, WithErrorCodes(test.codes))
+			_, _, fetchErr := f.InitializeAsserter(context.Background(), nil, "")
+			assert.True(t, checkError(fetchErr, test.expectedError))
+		})
+	}
+}
@@ -15,8 +15,12 @@
 package fetcher
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/dominant-strategies/mesh-sdk-go/asserter"
 	"github.com/dominant-strategies/mesh-sdk-go/client"
 )
@@ -33,6 +37,18 @@ func WithClient(client *client.APIClient) Option {
 	}
 }
 
+// WithMeshClient invokes the non-streaming endpoints with
+// a MeshClient (like a *meshgrpc.Client) instead of the
+// client.APIClient. Options that configure HTTP requests
+// (like WithEndpointPool, WithRateLimit, and WithBasicAuth)
+// do not apply to a MeshClient, and BlockStream and
+// EventsBlocksStream still use the client.APIClient.
+func WithMeshClient(meshClient MeshClient) Option {
+	return func(f *Fetcher) {
+		f.meshClient = meshClient
+	}
+}
+
 // WithMaxRetries overrides the default number of retries on
 // a request.
 func WithMaxRetries(maxRetries uint64) Option {
@@ -70,6 +86,69 @@ func WithInsecureTLS() Option {
 	}
 }
 
+// WithClientCertificate presents the provided certificate
+// when a server requests one (mutual TLS). It can be provided
+// multiple times. This only applies to the default client (it
+// is ignored if WithClient is provided).
+func WithClientCertificate(certificate tls.Certificate) Option {
+	return func(f *Fetcher) {
+		f.certificates = append(f.certificates, certificate)
+	}
+}
+
+// WithRootCAs overrides the certificate authorities used to
+// verify servers (the host's root CAs are used by default). This
+// only applies to the default client (it is ignored if WithClient
+// is provided).
+func WithRootCAs(rootCAs *x509.CertPool) Option {
+	return func(f *Fetcher) {
+		f.rootCAs = rootCAs
+	}
+}
+
+// WithBasicAuth adds HTTP basic authentication to each
+// request. This only applies to the default client (it
+// is ignored if WithClient is provided).
+func WithBasicAuth(username string, password string) Option {
+	return func(f *Fetcher) {
+		f.auth.basicAuth = true
+		f.auth.basicUsername = username
+		f.auth.basicPassword = password
+	}
+}
+
+// WithAPIKey populates the provided header with key on
+// each request. This only applies to the default client
+// (it is ignored if WithClient is provided).
+func WithAPIKey(header string, key string) Option {
+	return func(f *Fetcher) {
+		f.auth.apiKeyHeader = header
+		f.auth.apiKey = key
+	}
+}
+
+// WithBearerToken adds a static bearer token to each
+// request (taking precedence over WithBasicAuth). This
+// only applies to the default client (it is ignored if
+// WithClient is provided).
+func WithBearerToken(token string) Option {
+	return func(f *Fetcher) {
+		f.auth.bearerToken = token
+	}
+}
+
+// WithTokenSource adds a bearer token fetched from source
+// to each request (taking precedence over WithBasicAuth and
+// WithBearerToken). When a request is rejected with a 401,
+// a new token is fetched and the request is attempted once
+// more. This only applies to the default client (it is ignored
+// if WithClient is provided).
+func WithTokenSource(source TokenSource) Option {
+	return func(f *Fetcher) {
+		f.auth.tokenSource = source
+	}
+}
+
 // WithTimeout overrides the default HTTP timeout.
 func WithTimeout(timeout time.Duration) Option {
 	return func(f *Fetcher) {
@@ -85,6 +164,53 @@ func WithMaxConnections(connections int) Option {
 	}
 }
 
+// WithRateLimit limits the number of requests per second
+// made to each endpoint using a token bucket that holds up
+// to burst tokens. This only applies to the default client
+// (it is ignored if WithClient is provided).
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(f *Fetcher) {
+		f.limiter.rate = requestsPerSecond
+		f.limiter.burst = burst
+	}
+}
+
+// WithAdaptiveConcurrency limits the number of concurrent
+// requests made to each endpoint to a value between min and max
+// (starting at initial). The limit shrinks when an endpoint times out
+// or responds with a 429, 502, 503, or 504 status code (or a 500 with
+// a retriable *types.Error), and grows on success.
+// The values are clamped so that 1 <= min <= initial <= max.
+// This only applies to the default client (it is ignored if WithClient
+// is provided).
+func WithAdaptiveConcurrency(initial int, min int, max int) Option {
+	return func(f *Fetcher) {
+		f.limiter.adaptiveInitial = initial
+		f.limiter.adaptiveMin = min
+		f.limiter.adaptiveMax = max
+	}
+}
+
+// WithResponseCache serves immutable responses from a
+// *ResponseCache. This includes blocks requested by hash,
+// transactions fetched from /block/transaction, and
+// account balances requested at a block hash.
+func WithResponseCache(cache *ResponseCache) Option {
+	return func(f *Fetcher) {
+		f.responseCache = cache
+	}
+}
+
+// WithErrorCodes classifies errors returned by the server
+// with the provided codes (see IsNotFound, IsNodeBehind, and
+// IsRateLimited). InitializeAsserter returns an error if any
+// code is not declared in /network/options.
+func WithErrorCodes(codes *ErrorCodes) Option {
+	return func(f *Fetcher) {
+		f.errorCodes = codes
+	}
+}
+
 // WithForceRetry overrides the default
 // retry handling logic and treats every error
 // as retriable.
@@ -100,6 +226,28 @@ func WithForceRetry() Option {
 	}
 }
 
+// WithEndpointPool routes all requests made by the
+// default client through an *EndpointPool. If the
+// serverAddress provided to New is empty, the address
+// of the first endpoint in the pool is used.
+func WithEndpointPool(pool *EndpointPool) Option {
+	return func(f *Fetcher) {
+		f.endpointPool = pool
+	}
+}
+
+// WithTracerProvider creates spans for each *Retry
+// method (with a child span for each attempt) using
+// the provided trace.TracerProvider. The default client
+// also creates a span for each request and propagates it
+// to the server (set client.Configuration.TracerProvider
+// when using WithClient).
+func WithTracerProvider(tracerProvider trace.TracerProvider) Option {
+	return func(f *Fetcher) {
+		f.tracerProvider = tracerProvider
+	}
+}
+
 // add a metaData map to fetcher
 func WithMetaData(metaData string) Option {
 	return func(f *Fetcher) {
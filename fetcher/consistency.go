@@ -0,0 +1,297 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+const (
+	// BlockMethod is the Method of a *Mismatch
+	// returned by /block.
+	BlockMethod = "/block"
+
+	// AccountBalanceMethod is the Method of a *Mismatch
+	// returned by /account/balance.
+	AccountBalanceMethod = "/account/balance"
+
+	// NetworkStatusMethod is the Method of a *Mismatch
+	// returned by /network/status.
+	NetworkStatusMethod = "/network/status"
+)
+
+// ConsistencyResult is the response returned by
+// a single endpoint in a *ConsistencyChecker.
+type ConsistencyResult struct {
+	Address string `json:"address"`
+
+	// Hash is the types.Hash of the response. It is
+	// empty if the request failed.
+	Hash     string      `json:"hash,omitempty"`
+	Response interface{} `json:"response,omitempty"`
+	Err      *Error      `json:"err,omitempty"`
+}
+
+// Mismatch is reported when the endpoints of a
+// *ConsistencyChecker return different responses
+// to the same request.
+type Mismatch struct {
+	Method  string               `json:"method"`
+	Request interface{}          `json:"request"`
+	Results []*ConsistencyResult `json:"results"`
+}
+
+// MismatchHandler is invoked with each *Mismatch
+// found by a *ConsistencyChecker.
+type MismatchHandler func(ctx context.Context, mismatch *Mismatch)
+
+// ConsistencyOption is used to overwrite default values in
+// ConsistencyChecker construction. Any ConsistencyOption not
+// provided falls back to the default value.
+type ConsistencyOption func(c *ConsistencyChecker)
+
+// WithMismatchHandler sets the MismatchHandler
+// invoked when responses diverge.
+func WithMismatchHandler(handler MismatchHandler) ConsistencyOption {
+	return func(c *ConsistencyChecker) {
+		c.handler = handler
+	}
+}
+
+// WithMajorityResponse returns the response shared by a
+// majority of endpoints instead of the response of the
+// first endpoint. If no response is shared by a majority,
+// ErrConsistencyNoMajority is returned.
+func WithMajorityResponse() ConsistencyOption {
+	return func(c *ConsistencyChecker) {
+		c.majority = true
+	}
+}
+
+// ConsistencyChecker issues each request to multiple
+// Fetchers and compares the responses with types.Hash.
+//
+// By default, the response of the first Fetcher is
+// returned (the others are only used for comparison).
+type ConsistencyChecker struct {
+	fetchers []*Fetcher
+	handler  MismatchHandler
+	majority bool
+}
+
+// NewConsistencyChecker constructs a new *ConsistencyChecker
+// that compares the responses of at least 2 Fetchers.
+func NewConsistencyChecker(
+	fetchers []*Fetcher,
+	options ...ConsistencyOption,
+) (*ConsistencyChecker, error) {
+	if len(fetchers) < 2 { // nolint:gomnd
+		return nil, fmt.Errorf(
+			"%d fetchers provided: %w",
+			len(fetchers),
+			ErrConsistencyTooFewFetchers,
+		)
+	}
+
+	c := &ConsistencyChecker{
+		fetchers: fetchers,
+	}
+
+	for _, opt := range options {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// check invokes fetch on all Fetchers concurrently, reports
+// any *Mismatch, and selects the response to return.
+func (c *ConsistencyChecker) check(
+	ctx context.Context,
+	method string,
+	request interface{},
+	fetch func(f *Fetcher) (interface{}, *Error),
+) (interface{}, *Error) {
+	results := make([]*ConsistencyResult, len(c.fetchers))
+
+	var wg sync.WaitGroup
+	for i, f := range c.fetchers {
+		wg.Add(1)
+		go func(i int, f *Fetcher) {
+			defer wg.Done()
+
+			result := &ConsistencyResult{
+				Address: f.rosettaClient.GetConfig().BasePath,
+			}
+			result.Response, result.Err = fetch(f)
+			if result.Err == nil {
+				result.Hash = types.Hash(result.Response)
+			}
+
+			results[i] = result
+		}(i, f)
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return nil, &Error{Err: ctx.Err()}
+	}
+
+	// Count the results with each hash (failed
+	// requests are grouped under the empty hash).
+	counts := map[string]int{}
+	for _, result := range results {
+		counts[result.Hash]++
+	}
+
+	if len(counts) > 1 && c.handler != nil {
+		c.handler(ctx, &Mismatch{
+			Method:  method,
+			Request: request,
+			Results: results,
+		})
+	}
+
+	if !c.majority {
+		return results[0].Response, results[0].Err
+	}
+
+	for _, result := range results {
+		if counts[result.Hash]*2 > len(results) { // nolint:gomnd
+			return result.Response, result.Err
+		}
+	}
+
+	return nil, &Error{
+		Err: fmt.Errorf(
+			"%s returned %d distinct responses from %d fetchers: %w",
+			method,
+			len(counts),
+			len(results),
+			ErrConsistencyNoMajority,
+		),
+	}
+}
+
+// BlockRetry invokes BlockRetry on all Fetchers
+// and compares the returned *types.Block.
+func (c *ConsistencyChecker) BlockRetry(
+	ctx context.Context,
+	network *types.NetworkIdentifier,
+	blockIdentifier *types.PartialBlockIdentifier,
+) (*types.Block, *Error) {
+	request := &types.BlockRequest{
+		NetworkIdentifier: network,
+		BlockIdentifier:   blockIdentifier,
+	}
+
+	response, err := c.check(
+		ctx,
+		BlockMethod,
+		request,
+		func(f *Fetcher) (interface{}, *Error) {
+			return f.BlockRetry(ctx, network, blockIdentifier)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*types.Block), nil
+}
+
+// AccountBalanceRetry invokes AccountBalanceRetry on all
+// Fetchers and compares the returned block, balances,
+// and metadata.
+func (c *ConsistencyChecker) AccountBalanceRetry(
+	ctx context.Context,
+	network *types.NetworkIdentifier,
+	account *types.AccountIdentifier,
+	block *types.PartialBlockIdentifier,
+	currencies []*types.Currency,
+) (*types.BlockIdentifier, []*types.Amount, map[string]interface{}, *Error) {
+	request := &types.AccountBalanceRequest{
+		NetworkIdentifier: network,
+		AccountIdentifier: account,
+		BlockIdentifier:   block,
+		Currencies:        currencies,
+	}
+
+	response, err := c.check(
+		ctx,
+		AccountBalanceMethod,
+		request,
+		func(f *Fetcher) (interface{}, *Error) {
+			blockIdentifier, balances, metadata, err := f.AccountBalanceRetry(
+				ctx,
+				network,
+				account,
+				block,
+				currencies,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return &types.AccountBalanceResponse{
+				BlockIdentifier: blockIdentifier,
+				Balances:        balances,
+				Metadata:        metadata,
+			}, nil
+		},
+	)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	balanceResponse := response.(*types.AccountBalanceResponse)
+	return balanceResponse.BlockIdentifier,
+		balanceResponse.Balances,
+		balanceResponse.Metadata,
+		nil
+}
+
+// NetworkStatusRetry invokes NetworkStatusRetry on all
+// Fetchers and compares the returned *types.NetworkStatusResponse.
+// Note that endpoints syncing at different speeds will
+// report a *Mismatch.
+func (c *ConsistencyChecker) NetworkStatusRetry(
+	ctx context.Context,
+	network *types.NetworkIdentifier,
+	metadata map[string]interface{},
+) (*types.NetworkStatusResponse, *Error) {
+	request := &types.NetworkRequest{
+		NetworkIdentifier: network,
+		Metadata:          metadata,
+	}
+
+	response, err := c.check(
+		ctx,
+		NetworkStatusMethod,
+		request,
+		func(f *Fetcher) (interface{}, *Error) {
+			return f.NetworkStatusRetry(ctx, network, metadata)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*types.NetworkStatusResponse), nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+func TestNewConsistencyChecker(t *testing.T) {
+	_, err := NewConsistencyChecker([]*Fetcher{New("http://localhost")})
+	assert.ErrorIs(t, err, ErrConsistencyTooFewFetchers)
+}
+
+func TestConsistencyCheckerNetworkStatus(t *testing.T) {
+	otherStatus := &types.NetworkStatusResponse{
+		CurrentBlockIdentifier: &types.BlockIdentifier{
+			Index: basicBlock.Index + 1,
+			Hash:  "other",
+		},
+		CurrentBlockTimestamp:  basicNetworkStatus.CurrentBlockTimestamp,
+		GenesisBlockIdentifier: basicNetworkStatus.GenesisBlockIdentifier,
+	}
+
+	var tests = map[string]struct {
+		statuses []*types.NetworkStatusResponse
+		majority bool
+
+		expectedStatus   *types.NetworkStatusResponse
+		expectedError    error
+		expectedMismatch bool
+	}{
+		"consistent": {
+			statuses: []*types.NetworkStatusResponse{
+				basicNetworkStatus,
+				basicNetworkStatus,
+				basicNetworkStatus,
+			},
+			expectedStatus: basicNetworkStatus,
+		},
+		"first response": {
+			statuses: []*types.NetworkStatusResponse{
+				otherStatus,
+				basicNetworkStatus,
+				basicNetworkStatus,
+			},
+			expectedStatus:   otherStatus,
+			expectedMismatch: true,
+		},
+		"majority response": {
+			statuses: []*types.NetworkStatusResponse{
+				otherStatus,
+				basicNetworkStatus,
+				basicNetworkStatus,
+			},
+			majority:         true,
+			expectedStatus:   basicNetworkStatus,
+			expectedMismatch: true,
+		},
+		"no majority": {
+			statuses: []*types.NetworkStatusResponse{
+				otherStatus,
+				basicNetworkStatus,
+			},
+			majority:         true,
+			expectedError:    ErrConsistencyNoMajority,
+			expectedMismatch: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var (
+				assert = assert.New(t)
+				ctx    = context.Background()
+				calls  = make([]int, len(test.statuses))
+			)
+
+			fetchers := make([]*Fetcher, len(test.statuses))
+			for i, status := range test.statuses {
+				ts := newEndpointServer(t, http.StatusOK, status, &calls[i])
+				defer ts.Close()
+
+				fetchers[i] = New(ts.URL, WithRetryElapsedTime(5*time.Second))
+			}
+
+			var mismatch *Mismatch
+			options := []ConsistencyOption{
+				WithMismatchHandler(func(ctx context.Context, m *Mismatch) {
+					mismatch = m
+				}),
+			}
+			if test.majority {
+				options = append(options, WithMajorityResponse())
+			}
+
+			c, err := NewConsistencyChecker(fetchers, options...)
+			assert.NoError(err)
+
+			status, fetchErr := c.NetworkStatusRetry(ctx, basicNetwork, nil)
+			assert.Equal(test.expectedStatus, status)
+			assert.True(checkError(fetchErr, test.expectedError))
+
+			if !test.expectedMismatch {
+				assert.Nil(mismatch)
+				return
+			}
+
+			assert.Equal(NetworkStatusMethod, mismatch.Method)
+			assert.Len(mismatch.Results, len(test.statuses))
+			for i, result := range mismatch.Results {
+				assert.Equal(fetchers[i].rosettaClient.GetConfig().BasePath, result.Address)
+				assert.Equal(types.Hash(test.statuses[i]), result.Hash)
+			}
+		})
+	}
+}
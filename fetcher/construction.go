@@ -40,7 +40,7 @@ func (f *Fetcher) ConstructionCombine(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	response, clientErr, err := f.rosettaClient.ConstructionAPI.ConstructionCombine(ctx,
+	response, clientErr, err := f.meshClient.ConstructionCombine(ctx,
 		&types.ConstructionCombineRequest{
 			NetworkIdentifier:   network,
 			UnsignedTransaction: unsignedTransaction,
@@ -79,7 +79,7 @@ func (f *Fetcher) ConstructionDerive(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	response, clientErr, err := f.rosettaClient.ConstructionAPI.ConstructionDerive(ctx,
+	response, clientErr, err := f.meshClient.ConstructionDerive(ctx,
 		&types.ConstructionDeriveRequest{
 			NetworkIdentifier: network,
 			PublicKey:         publicKey,
@@ -114,7 +114,7 @@ func (f *Fetcher) ConstructionHash(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	response, clientErr, err := f.rosettaClient.ConstructionAPI.ConstructionHash(ctx,
+	response, clientErr, err := f.meshClient.ConstructionHash(ctx,
 		&types.ConstructionHashRequest{
 			NetworkIdentifier: network,
 			SignedTransaction: signedTransaction,
@@ -149,7 +149,7 @@ func (f *Fetcher) ConstructionMetadata(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	metadata, clientErr, err := f.rosettaClient.ConstructionAPI.ConstructionMetadata(ctx,
+	metadata, clientErr, err := f.meshClient.ConstructionMetadata(ctx,
 		&types.ConstructionMetadataRequest{
 			NetworkIdentifier: network,
 			Options:           options,
@@ -188,7 +188,7 @@ func (f *Fetcher) ConstructionParse(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	response, clientErr, err := f.rosettaClient.ConstructionAPI.ConstructionParse(ctx,
+	response, clientErr, err := f.meshClient.ConstructionParse(ctx,
 		&types.ConstructionParseRequest{
 			NetworkIdentifier: network,
 			Signed:            signed,
@@ -234,7 +234,7 @@ func (f *Fetcher) ConstructionPayloads(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	response, clientErr, err := f.rosettaClient.ConstructionAPI.ConstructionPayloads(ctx,
+	response, clientErr, err := f.meshClient.ConstructionPayloads(ctx,
 		&types.ConstructionPayloadsRequest{
 			NetworkIdentifier: network,
 			Operations:        operations,
@@ -277,7 +277,7 @@ func (f *Fetcher) ConstructionPreprocess(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	response, clientErr, err := f.rosettaClient.ConstructionAPI.ConstructionPreprocess(ctx,
+	response, clientErr, err := f.meshClient.ConstructionPreprocess(ctx,
 		&types.ConstructionPreprocessRequest{
 			NetworkIdentifier: network,
 			Operations:        operations,
@@ -313,7 +313,7 @@ func (f *Fetcher) ConstructionSubmit(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	submitResponse, clientErr, err := f.rosettaClient.ConstructionAPI.ConstructionSubmit(
+	submitResponse, clientErr, err := f.meshClient.ConstructionSubmit(
 		ctx,
 		&types.ConstructionSubmitRequest{
 			NetworkIdentifier: network,
@@ -0,0 +1,519 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/dominant-strategies/mesh-sdk-go/client"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// EndpointPolicy determines the order in which
+// the endpoints of an *EndpointPool are attempted.
+type EndpointPolicy string
+
+const (
+	// PrimaryBackupPolicy attempts endpoints in the
+	// order they were provided.
+	PrimaryBackupPolicy EndpointPolicy = "primary_backup"
+
+	// RoundRobinPolicy rotates the first endpoint
+	// attempted on each request.
+	RoundRobinPolicy EndpointPolicy = "round_robin"
+
+	// LeastLatencyPolicy attempts the endpoint with
+	// the lowest observed latency first.
+	LeastLatencyPolicy EndpointPolicy = "least_latency"
+
+	// HighestTipPolicy attempts the endpoint with
+	// the highest current block (from the last health
+	// check) first.
+	HighestTipPolicy EndpointPolicy = "highest_tip"
+)
+
+const (
+	// DefaultHealthCheckInterval is the default time
+	// between endpoint health checks.
+	DefaultHealthCheckInterval = 10 * time.Second
+
+	// latencyWeight is the weight given to each new
+	// latency observation in the exponentially weighted
+	// moving average of an endpoint's latency.
+	latencyWeight = 0.2
+)
+
+// EndpointStatus is the last known state of an endpoint
+// in an *EndpointPool.
+type EndpointStatus struct {
+	Address string `json:"address"`
+
+	// Healthy is false if the last health check or request
+	// to the endpoint failed with a transient error.
+	Healthy bool `json:"healthy"`
+
+	// Latency is the moving average of the time taken
+	// by successful requests.
+	Latency time.Duration `json:"latency"`
+
+	// Tip is the current block returned by the last
+	// successful health check.
+	Tip *types.BlockIdentifier `json:"tip,omitempty"`
+
+	LastCheck time.Time `json:"last_check"`
+	LastError string    `json:"last_error,omitempty"`
+}
+
+type endpoint struct {
+	url *url.URL
+
+	statusMutex sync.Mutex
+	status      *EndpointStatus
+}
+
+func (e *endpoint) getStatus() *EndpointStatus {
+	e.statusMutex.Lock()
+	defer e.statusMutex.Unlock()
+
+	status := *e.status
+	return &status
+}
+
+func (e *endpoint) recordSuccess(latency time.Duration) {
+	e.statusMutex.Lock()
+	defer e.statusMutex.Unlock()
+
+	e.status.Healthy = true
+	e.status.LastError = ""
+	if e.status.Latency == 0 {
+		e.status.Latency = latency
+		return
+	}
+
+	e.status.Latency = time.Duration(
+		float64(e.status.Latency)*(1-latencyWeight) + float64(latency)*latencyWeight,
+	)
+}
+
+func (e *endpoint) recordFailure(err error) {
+	e.statusMutex.Lock()
+	defer e.statusMutex.Unlock()
+
+	e.status.Healthy = false
+	e.status.LastError = err.Error()
+}
+
+// EndpointPoolOption is used to overwrite default values in
+// EndpointPool construction. Any EndpointPoolOption not provided
+// falls back to the default value.
+type EndpointPoolOption func(p *EndpointPool)
+
+// WithHealthCheckNetwork sets the *types.NetworkIdentifier
+// used to query /network/status during health checks. If this
+// is not provided, the first network returned by /network/list
+// is used.
+func WithHealthCheckNetwork(network *types.NetworkIdentifier) EndpointPoolOption {
+	return func(p *EndpointPool) {
+		p.network = network
+	}
+}
+
+// WithHealthCheckInterval overrides the default time between
+// health checks in HealthCheckLoop.
+func WithHealthCheckInterval(interval time.Duration) EndpointPoolOption {
+	return func(p *EndpointPool) {
+		p.healthCheckInterval = interval
+	}
+}
+
+// WithEndpointTransport overrides the http.RoundTripper used to
+// make requests to each endpoint. If this is not provided, the
+// default transport of the Fetcher is used.
+func WithEndpointTransport(transport http.RoundTripper) EndpointPoolOption {
+	return func(p *EndpointPool) {
+		p.transport = transport
+	}
+}
+
+// EndpointPool is an http.RoundTripper that routes each request
+// to one of several Rosetta servers according to an EndpointPolicy.
+// If a request fails with a transient error, it is attempted on the
+// next endpoint before returning an error.
+//
+// The EndpointPool should be provided to a Fetcher using
+// WithEndpointPool. Health checks are only performed when
+// CheckHealth or HealthCheckLoop is invoked.
+type EndpointPool struct {
+	endpoints           []*endpoint
+	policy              EndpointPolicy
+	network             *types.NetworkIdentifier
+	healthCheckInterval time.Duration
+	transport           http.RoundTripper
+
+	// next is the index of the endpoint attempted first
+	// by the RoundRobinPolicy.
+	next uint64
+}
+
+// NewEndpointPool constructs a new *EndpointPool for
+// a slice of Rosetta server addresses.
+func NewEndpointPool(
+	addresses []string,
+	policy EndpointPolicy,
+	options ...EndpointPoolOption,
+) (*EndpointPool, error) {
+	if len(addresses) == 0 {
+		return nil, ErrNoEndpoints
+	}
+
+	switch policy {
+	case PrimaryBackupPolicy, RoundRobinPolicy, LeastLatencyPolicy, HighestTipPolicy:
+	default:
+		return nil, fmt.Errorf("%s is not supported: %w", policy, ErrInvalidEndpointPolicy)
+	}
+
+	p := &EndpointPool{
+		endpoints:           make([]*endpoint, len(addresses)),
+		policy:              policy,
+		healthCheckInterval: DefaultHealthCheckInterval,
+	}
+
+	for i, address := range addresses {
+		parsed, err := url.Parse(address)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse endpoint %s: %w", address, err)
+		}
+
+		p.endpoints[i] = &endpoint{
+			url: parsed,
+			status: &EndpointStatus{
+				Address: address,
+				Healthy: true,
+			},
+		}
+	}
+
+	for _, opt := range options {
+		opt(p)
+	}
+
+	return p, nil
+}
+
+// Address returns the address of the first endpoint
+// in the *EndpointPool.
+func (p *EndpointPool) Address() string {
+	return p.endpoints[0].status.Address
+}
+
+// Statuses returns the last known *EndpointStatus
+// of each endpoint (in the order provided).
+func (p *EndpointPool) Statuses() []*EndpointStatus {
+	statuses := make([]*EndpointStatus, len(p.endpoints))
+	for i, e := range p.endpoints {
+		statuses[i] = e.getStatus()
+	}
+
+	return statuses
+}
+
+// roundTripper returns the http.RoundTripper used
+// to make requests to each endpoint.
+func (p *EndpointPool) roundTripper() http.RoundTripper {
+	if p.transport == nil {
+		return http.DefaultTransport
+	}
+
+	return p.transport
+}
+
+// candidates returns all endpoints in the order they
+// should be attempted. Unhealthy endpoints are always
+// attempted after healthy endpoints.
+func (p *EndpointPool) candidates() []*endpoint {
+	ordered := make([]*endpoint, len(p.endpoints))
+	statuses := map[*endpoint]*EndpointStatus{}
+	switch p.policy {
+	case RoundRobinPolicy:
+		start := int(atomic.AddUint64(&p.next, 1)-1) % len(p.endpoints)
+		for i := range p.endpoints {
+			ordered[i] = p.endpoints[(start+i)%len(p.endpoints)]
+		}
+	default:
+		copy(ordered, p.endpoints)
+	}
+
+	for _, e := range ordered {
+		statuses[e] = e.getStatus()
+	}
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		a, b := statuses[ordered[i]], statuses[ordered[j]]
+		if a.Healthy != b.Healthy {
+			return a.Healthy
+		}
+
+		switch p.policy {
+		case LeastLatencyPolicy:
+			// Endpoints without any observed latency are
+			// attempted after those with observed latency.
+			if a.Latency == 0 || b.Latency == 0 {
+				return a.Latency != 0
+			}
+
+			return a.Latency < b.Latency
+		case HighestTipPolicy:
+			return tipIndex(a.Tip) > tipIndex(b.Tip)
+		default:
+			return false
+		}
+	})
+
+	return ordered
+}
+
+func tipIndex(tip *types.BlockIdentifier) int64 {
+	if tip == nil {
+		return -1
+	}
+
+	return tip.Index
+}
+
+// rewrite returns a copy of an *http.Request addressed
+// to an endpoint. The path of the endpoint replaces the
+// path of the first endpoint in the pool (which is the
+// address provided to the Fetcher).
+func (p *EndpointPool) rewrite(
+	req *http.Request,
+	e *endpoint,
+	body []byte,
+) *http.Request {
+	outReq := req.Clone(req.Context())
+	outReq.URL.Scheme = e.url.Scheme
+	outReq.URL.Host = e.url.Host
+	outReq.URL.Path = e.url.Path + strings.TrimPrefix(req.URL.Path, p.endpoints[0].url.Path)
+	outReq.Host = ""
+
+	if body != nil {
+		outReq.Body = io.NopCloser(bytes.NewReader(body))
+		outReq.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		outReq.ContentLength = int64(len(body))
+	}
+
+	return outReq
+}
+
+// retriableStatus returns a boolean indicating if an HTTP status
+// code is converted to client.ErrRetriable by the client.
+func retriableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+		http.StatusRequestTimeout,
+		http.StatusTooManyRequests:
+		return true
+	default:
+		return false
+	}
+}
+
+// retriableResponse returns a boolean indicating if a response
+// is transient: it has a retriable status code or it is a 500 with
+// a retriable *types.Error (like a node that is not ready yet). The
+// body of a 500 is read and replaced so that it can be read again.
+func retriableResponse(resp *http.Response) bool {
+	if retriableStatus(resp.StatusCode) {
+		return true
+	}
+
+	if resp.StatusCode != http.StatusInternalServerError {
+		return false
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		// The connection failed while
+		// reading the response.
+		return true
+	}
+
+	var rosettaErr types.Error
+	return json.Unmarshal(body, &rosettaErr) == nil && rosettaErr.Retriable
+}
+
+// dialError returns a boolean indicating if an error occurred
+// while connecting to an endpoint. These requests never reached
+// the endpoint, so they are always safe to attempt elsewhere.
+func dialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// RoundTrip implements http.RoundTripper by attempting
+// the request on each endpoint (ordered by the EndpointPolicy)
+// until it succeeds or fails with an error that is not transient.
+func (p *EndpointPool) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read request body: %w", err)
+		}
+	}
+
+	candidates := p.candidates()
+	var lastErr error
+	for i, e := range candidates {
+		start := time.Now()
+		resp, err := p.roundTripper().RoundTrip(p.rewrite(req, e, body))
+		if err == nil && !retriableResponse(resp) {
+			e.recordSuccess(time.Since(start))
+			return resp, nil
+		}
+
+		if req.Context().Err() != nil {
+			return resp, err
+		}
+
+		if err == nil {
+			err = fmt.Errorf("status code %d: %w", resp.StatusCode, client.ErrRetriable)
+
+			// Return the retriable response from the last
+			// endpoint so that the client can classify it.
+			if i == len(candidates)-1 {
+				e.recordFailure(err)
+				return resp, nil
+			}
+
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		} else if !transientError(err) && !dialError(err) {
+			return nil, err
+		}
+
+		e.recordFailure(err)
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+// checkEndpoint updates the *EndpointStatus of an
+// endpoint by querying /network/status.
+func (p *EndpointPool) checkEndpoint(ctx context.Context, e *endpoint) {
+	apiClient := client.NewAPIClient(client.NewConfiguration(
+		e.status.Address,
+		DefaultUserAgent,
+		&http.Client{
+			Timeout:   DefaultHTTPTimeout,
+			Transport: p.roundTripper(),
+		},
+	))
+
+	network := p.network
+	if network == nil {
+		networkList, _, err := apiClient.NetworkAPI.NetworkList(ctx, &types.MetadataRequest{})
+		if err != nil {
+			e.recordFailure(fmt.Errorf("/network/list failed: %w", err))
+			return
+		}
+
+		if len(networkList.NetworkIdentifiers) == 0 {
+			e.recordFailure(ErrNoNetworks)
+			return
+		}
+
+		network = networkList.NetworkIdentifiers[0]
+	}
+
+	start := time.Now()
+	networkStatus, _, err := apiClient.NetworkAPI.NetworkStatus(
+		ctx,
+		&types.NetworkRequest{
+			NetworkIdentifier: network,
+		},
+	)
+	latency := time.Since(start)
+
+	e.statusMutex.Lock()
+	e.status.LastCheck = time.Now()
+	e.statusMutex.Unlock()
+
+	if err != nil {
+		e.recordFailure(fmt.Errorf("/network/status failed: %w", err))
+		return
+	}
+
+	e.statusMutex.Lock()
+	e.status.Tip = networkStatus.CurrentBlockIdentifier
+	e.statusMutex.Unlock()
+
+	e.recordSuccess(latency)
+}
+
+// CheckHealth queries /network/status on all endpoints
+// concurrently and updates their *EndpointStatus.
+func (p *EndpointPool) CheckHealth(ctx context.Context) {
+	g, ctx := errgroup.WithContext(ctx)
+	for _, e := range p.endpoints {
+		e := e
+		g.Go(func() error {
+			p.checkEndpoint(ctx, e)
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+}
+
+// HealthCheckLoop invokes CheckHealth every health check
+// interval until the context is canceled.
+func (p *EndpointPool) HealthCheckLoop(ctx context.Context) error {
+	ticker := time.NewTicker(p.healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		p.CheckHealth(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+func newEndpointServer(
+	t *testing.T,
+	status int,
+	networkStatus *types.NetworkStatusResponse,
+	calls *int,
+) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		*calls++
+
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.WriteHeader(status)
+
+		switch r.URL.RequestURI() {
+		case "/network/list":
+			fmt.Fprintln(w, types.PrettyPrintStruct(basicNetworkList))
+		case "/network/status":
+			fmt.Fprintln(w, types.PrettyPrintStruct(networkStatus))
+		}
+	}))
+}
+
+// newEndpointErrorServer responds to every
+// request with a 500 and rosettaErr.
+func newEndpointErrorServer(t *testing.T, rosettaErr *types.Error, calls *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		*calls++
+
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintln(w, types.PrettyPrintStruct(rosettaErr))
+	}))
+}
+
+func TestNewEndpointPool(t *testing.T) {
+	_, err := NewEndpointPool(nil, PrimaryBackupPolicy)
+	assert.ErrorIs(t, err, ErrNoEndpoints)
+
+	_, err = NewEndpointPool([]string{"http://localhost"}, "random")
+	assert.ErrorIs(t, err, ErrInvalidEndpointPolicy)
+}
+
+func TestEndpointPoolFailover(t *testing.T) {
+	var tests = map[string]struct {
+		primaryStatus int
+		primaryErr    *types.Error
+		primaryClosed bool
+
+		expectedPrimaryCalls int
+		expectedBackupCalls  int
+		expectedHealthy      bool
+	}{
+		"primary healthy": {
+			primaryStatus:        http.StatusOK,
+			expectedPrimaryCalls: 2,
+			expectedHealthy:      true,
+		},
+		"primary unavailable": {
+			primaryStatus:        http.StatusServiceUnavailable,
+			expectedPrimaryCalls: 1,
+			expectedBackupCalls:  2,
+		},
+		"primary rate limited": {
+			primaryStatus:        http.StatusTooManyRequests,
+			expectedPrimaryCalls: 1,
+			expectedBackupCalls:  2,
+		},
+		"primary not ready": {
+			primaryErr:           &types.Error{Code: 1, Message: "node not ready", Retriable: true},
+			expectedPrimaryCalls: 1,
+			expectedBackupCalls:  2,
+		},
+		"primary closed": {
+			primaryClosed:       true,
+			expectedBackupCalls: 2,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var (
+				assert       = assert.New(t)
+				ctx          = context.Background()
+				primaryCalls = 0
+				backupCalls  = 0
+			)
+
+			var primary *httptest.Server
+			if test.primaryErr != nil {
+				primary = newEndpointErrorServer(t, test.primaryErr, &primaryCalls)
+			} else {
+				primary = newEndpointServer(t, test.primaryStatus, basicNetworkStatus, &primaryCalls)
+			}
+			defer primary.Close()
+			if test.primaryClosed {
+				primary.Close()
+			}
+
+			backup := newEndpointServer(t, http.StatusOK, basicNetworkStatus, &backupCalls)
+			defer backup.Close()
+
+			pool, err := NewEndpointPool(
+				[]string{primary.URL, backup.URL},
+				PrimaryBackupPolicy,
+			)
+			assert.NoError(err)
+
+			f := New(
+				"",
+				WithEndpointPool(pool),
+				WithRetryElapsedTime(5*time.Second),
+			)
+
+			// The unhealthy primary is attempted after
+			// the backup on the second request.
+			for i := 0; i < 2; i++ {
+				status, fetchErr := f.NetworkStatusRetry(ctx, basicNetwork, nil)
+				assert.Nil(fetchErr)
+				assert.Equal(basicNetworkStatus, status)
+			}
+
+			assert.Equal(test.expectedPrimaryCalls, primaryCalls)
+			assert.Equal(test.expectedBackupCalls, backupCalls)
+
+			statuses := pool.Statuses()
+			assert.Equal(test.expectedHealthy, statuses[0].Healthy)
+			assert.True(statuses[1].Healthy)
+		})
+	}
+}
+
+func TestEndpointPoolNonRetriableError(t *testing.T) {
+	var (
+		assert       = assert.New(t)
+		ctx          = context.Background()
+		primaryCalls = 0
+		backupCalls  = 0
+		primaryErr   = &types.Error{Code: 2, Message: "account not found"}
+	)
+
+	primary := newEndpointErrorServer(t, primaryErr, &primaryCalls)
+	defer primary.Close()
+
+	backup := newEndpointServer(t, http.StatusOK, basicNetworkStatus, &backupCalls)
+	defer backup.Close()
+
+	pool, err := NewEndpointPool([]string{primary.URL, backup.URL}, PrimaryBackupPolicy)
+	assert.NoError(err)
+
+	// Non-retriable errors are returned by the
+	// primary without trying the backup.
+	f := New("", WithEndpointPool(pool))
+	_, fetchErr := f.NetworkStatus(ctx, basicNetwork, nil)
+	assert.NotNil(fetchErr)
+	assert.Equal(primaryErr, fetchErr.ClientErr)
+	assert.Equal(1, primaryCalls)
+	assert.Equal(0, backupCalls)
+	assert.True(pool.Statuses()[0].Healthy)
+}
+
+func TestEndpointPoolLastEndpointUnavailable(t *testing.T) {
+	var (
+		assert = assert.New(t)
+		ctx    = context.Background()
+		calls  = 0
+	)
+
+	s := newEndpointServer(t, http.StatusServiceUnavailable, basicNetworkStatus, &calls)
+	defer s.Close()
+
+	pool, err := NewEndpointPool([]string{s.URL}, PrimaryBackupPolicy)
+	assert.NoError(err)
+
+	f := New("", WithEndpointPool(pool))
+	_, fetchErr := f.NetworkStatus(ctx, basicNetwork, nil)
+	assert.NotNil(fetchErr)
+	assert.Equal(1, calls)
+
+	statuses := pool.Statuses()
+	assert.False(statuses[0].Healthy)
+	assert.Contains(statuses[0].LastError, "status code 503")
+}
+
+func TestEndpointPoolRoundRobin(t *testing.T) {
+	var (
+		assert = assert.New(t)
+		ctx    = context.Background()
+		calls  = make([]int, 3)
+	)
+
+	addresses := make([]string, len(calls))
+	for i := range calls {
+		ts := newEndpointServer(t, http.StatusOK, basicNetworkStatus, &calls[i])
+		defer ts.Close()
+		addresses[i] = ts.URL
+	}
+
+	pool, err := NewEndpointPool(addresses, RoundRobinPolicy)
+	assert.NoError(err)
+
+	f := New("", WithEndpointPool(pool))
+	for i := 0; i < 6; i++ {
+		_, fetchErr := f.NetworkStatusRetry(ctx, basicNetwork, nil)
+		assert.Nil(fetchErr)
+	}
+
+	assert.Equal([]int{2, 2, 2}, calls)
+}
+
+func TestEndpointPoolHighestTip(t *testing.T) {
+	var (
+		assert      = assert.New(t)
+		ctx         = context.Background()
+		behindCalls = 0
+		aheadCalls  = 0
+	)
+
+	aheadStatus := &types.NetworkStatusResponse{
+		CurrentBlockIdentifier: &types.BlockIdentifier{
+			Index: basicBlock.Index + 10,
+			Hash:  "ahead",
+		},
+		CurrentBlockTimestamp:  basicNetworkStatus.CurrentBlockTimestamp,
+		GenesisBlockIdentifier: basicNetworkStatus.GenesisBlockIdentifier,
+	}
+
+	behind := newEndpointServer(t, http.StatusOK, basicNetworkStatus, &behindCalls)
+	defer behind.Close()
+	ahead := newEndpointServer(t, http.StatusOK, aheadStatus, &aheadCalls)
+	defer ahead.Close()
+
+	pool, err := NewEndpointPool([]string{behind.URL, ahead.URL}, HighestTipPolicy)
+	assert.NoError(err)
+
+	f := New("", WithEndpointPool(pool))
+	pool.CheckHealth(ctx)
+
+	statuses := pool.Statuses()
+	assert.Equal(basicBlock, statuses[0].Tip)
+	assert.Equal(aheadStatus.CurrentBlockIdentifier, statuses[1].Tip)
+	assert.False(statuses[1].LastCheck.IsZero())
+
+	status, fetchErr := f.NetworkStatusRetry(ctx, basicNetwork, nil)
+	assert.Nil(fetchErr)
+	assert.Equal(aheadStatus, status)
+
+	// Each health check queries /network/list and /network/status.
+	assert.Equal(2, behindCalls)
+	assert.Equal(3, aheadCalls)
+}
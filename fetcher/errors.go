@@ -71,8 +71,16 @@ func (f *Fetcher) RequestFailedError(
 		color.Red(errForPrint.Error())
 	}
 
+	// Wrap err (instead of only including its message) so that
+	// the request can be classified with IsRetryable, IsNotFound,
+	// IsNodeBehind, and IsRateLimited.
+	requestErr := fmt.Errorf("%s %w: %w", message, err, ErrRequestFailed)
+	if classErr := f.errorCodes.classify(rosettaErr); classErr != nil {
+		requestErr = fmt.Errorf("%w: %w", requestErr, classErr)
+	}
+
 	return &Error{
-		Err:       fmt.Errorf("%s %s: %w", message, err.Error(), ErrRequestFailed),
+		Err:       requestErr,
 		ClientErr: rosettaErr,
 		Retry: ((rosettaErr != nil && rosettaErr.Retriable) || transientError(err) || f.forceRetry) &&
 			!errors.Is(err, context.Canceled),
@@ -95,6 +103,62 @@ var (
 	// ErrExhaustedRetries is returned when a request with retries
 	// fails because it was attempted too many times.
 	ErrExhaustedRetries = errors.New("retries exhausted")
+
+	// ErrNoEndpoints is returned when constructing an
+	// *EndpointPool without any addresses.
+	ErrNoEndpoints = errors.New("no endpoints provided")
+
+	// ErrInvalidEndpointPolicy is returned when constructing
+	// an *EndpointPool with an unsupported EndpointPolicy.
+	ErrInvalidEndpointPolicy = errors.New("invalid endpoint policy")
+
+	// ErrConsistencyTooFewFetchers is returned when constructing
+	// a *ConsistencyChecker with fewer than 2 Fetchers.
+	ErrConsistencyTooFewFetchers = errors.New("at least 2 fetchers are required")
+
+	// ErrConsistencyNoMajority is returned by a *ConsistencyChecker
+	// using WithMajorityResponse when no response is shared by
+	// a majority of Fetchers.
+	ErrConsistencyNoMajority = errors.New("no response shared by a majority of fetchers")
+
+	// ErrBlockStreamInterrupted is returned by StreamBlockRetry
+	// when a stream fails after at least one transaction was
+	// provided to the TransactionHandler (so it cannot be retried).
+	ErrBlockStreamInterrupted = errors.New("block stream interrupted after handling transactions")
+
+	// ErrBlockStreamHeaderMissing is returned by StreamBlock when
+	// more than maxPendingStreamTransactions transactions precede
+	// the fields required to validate the block header (holding
+	// them would defeat the purpose of streaming the block).
+	ErrBlockStreamHeaderMissing = errors.New(
+		"block header not found before too many transactions",
+	)
+
+	// ErrDuplicateTransactionHash is returned by StreamBlock
+	// when a block contains more than one transaction with
+	// the same hash.
+	ErrDuplicateTransactionHash = errors.New("duplicate transaction hash in block")
+
+	// ErrBlockEventStreamClosed is returned by SubscribeBlockEvents
+	// when the server closes /events/blocks/stream.
+	ErrBlockEventStreamClosed = errors.New("block event stream closed by server")
+
+	// ErrNotFound is wrapped by errors returned from requests
+	// that failed with a code in ErrorCodes.NotFound.
+	ErrNotFound = errors.New("not found")
+
+	// ErrNodeBehind is wrapped by errors returned from requests
+	// that failed with a code in ErrorCodes.NodeBehind.
+	ErrNodeBehind = errors.New("node behind")
+
+	// ErrRateLimited is wrapped by errors returned from requests
+	// that failed with a code in ErrorCodes.RateLimited.
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrUndeclaredErrorCode is returned during asserter initialization
+	// when a code provided with WithErrorCodes is not declared
+	// in the *types.NetworkOptionsResponse.
+	ErrUndeclaredErrorCode = errors.New("error code not declared in network options")
 )
 
 // Err takes an error as an argument and returns
@@ -105,6 +169,17 @@ func Err(err error) bool {
 		ErrNetworkMissing,
 		ErrRequestFailed,
 		ErrExhaustedRetries,
+		ErrNoEndpoints,
+		ErrInvalidEndpointPolicy,
+		ErrConsistencyTooFewFetchers,
+		ErrConsistencyNoMajority,
+		ErrBlockStreamInterrupted,
+		ErrDuplicateTransactionHash,
+		ErrBlockEventStreamClosed,
+		ErrNotFound,
+		ErrNodeBehind,
+		ErrRateLimited,
+		ErrUndeclaredErrorCode,
 	}
 
 	return utils.FindError(fetcherErrors, err)
@@ -17,6 +17,7 @@ package fetcher
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/dominant-strategies/mesh-sdk-go/asserter"
 	"github.com/dominant-strategies/mesh-sdk-go/types"
@@ -37,7 +38,7 @@ func (f *Fetcher) EventsBlocks(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	response, clientErr, err := f.rosettaClient.EventsAPI.EventsBlocks(ctx,
+	response, clientErr, err := f.meshClient.EventsBlocks(ctx,
 		&types.EventsBlocksRequest{
 			NetworkIdentifier: network,
 			Offset:            offset,
@@ -70,19 +71,24 @@ func (f *Fetcher) EventsBlocksRetry(
 	network *types.NetworkIdentifier,
 	offset *int64,
 	limit *int64,
-) (int64, []*types.BlockEvent, *Error) {
+) (_ int64, _ []*types.BlockEvent, fetchErr *Error) {
+	ctx, span := f.startSpan(ctx, "EventsBlocksRetry")
+	defer func() { endSpan(span, fetchErr) }()
+
 	backoffRetries := backoffRetries(
 		f.retryElapsedTime,
 		f.maxRetries,
 	)
 
 	for {
+		attemptCtx, attempt := f.startAttempt(ctx, "EventsBlocksRetry", backoffRetries)
 		maxSequence, events, err := f.EventsBlocks(
-			ctx,
+			attemptCtx,
 			network,
 			offset,
 			limit,
 		)
+		endSpan(attempt, err)
 		if err == nil {
 			return maxSequence, events, nil
 		}
@@ -110,3 +116,146 @@ func (f *Fetcher) EventsBlocksRetry(
 		}
 	}
 }
+
+// BlockEventHandler is invoked with each *types.BlockEvent
+// received by SubscribeBlockEvents (in order of sequence).
+type BlockEventHandler func(ctx context.Context, event *types.BlockEvent) error
+
+// SubscribeBlockEvents invokes handler with each validated
+// *types.BlockEvent streamed from /events/blocks/stream, starting
+// at offset, until ctx is done, the stream fails, or handler returns
+// an error. It returns the sequence to resume the subscription from
+// (the sequence after the last handled event).
+//
+// If the server closes the stream, ErrBlockEventStreamClosed
+// is returned (and can be retried).
+func (f *Fetcher) SubscribeBlockEvents(
+	ctx context.Context,
+	network *types.NetworkIdentifier,
+	offset int64,
+	handler BlockEventHandler,
+) (int64, *Error) {
+	// The semaphore is only held while opening the stream so
+	// that long-lived subscriptions don't block other requests.
+	if err := f.connectionSemaphore.Acquire(ctx, semaphoreRequestWeight); err != nil {
+		return offset, &Error{
+			Err: fmt.Errorf("failed to acquire semaphore: %w", err),
+		}
+	}
+
+	stream, clientErr, err := f.rosettaClient.EventsAPI.EventsBlocksStream(ctx,
+		&types.EventsBlocksRequest{
+			NetworkIdentifier: network,
+			Offset:            types.Int64(offset),
+		},
+	)
+	f.connectionSemaphore.Release(semaphoreRequestWeight)
+	if err != nil {
+		return offset, f.RequestFailedError(clientErr, err, "/events/blocks/stream")
+	}
+	defer stream.Close()
+
+	next := offset
+	for {
+		event, clientErr, err := stream.Next()
+		if err == io.EOF {
+			return next, &Error{
+				Err:   ErrBlockEventStreamClosed,
+				Retry: true,
+			}
+		}
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return next, &Error{Err: ctx.Err()}
+			}
+
+			return next, f.RequestFailedError(clientErr, err, "/events/blocks/stream")
+		}
+
+		if err := asserter.BlockEvent(event); err != nil {
+			return next, &Error{
+				Err: fmt.Errorf("/events/blocks/stream event is invalid: %w", err),
+			}
+		}
+
+		// The first event may be after offset (if earlier
+		// events were pruned) but subsequent events must be
+		// contiguous.
+		if event.Sequence < next || (next != offset && event.Sequence != next) {
+			return next, &Error{
+				Err: fmt.Errorf(
+					"%w: expected sequence %d but received %d",
+					asserter.ErrSequenceOutOfOrder,
+					next,
+					event.Sequence,
+				),
+			}
+		}
+
+		if err := handler(ctx, event); err != nil {
+			return next, &Error{
+				Err: fmt.Errorf("block event handler failed: %w", err),
+			}
+		}
+
+		next = event.Sequence + 1
+	}
+}
+
+// SubscribeBlockEventsRetry invokes handler with each validated
+// *types.BlockEvent like SubscribeBlockEvents, resuming the
+// subscription from the last handled event when the stream fails
+// (or is closed by the server). It only returns when ctx is done,
+// handler returns an error, or retries are exhausted. The backoff
+// is reset whenever an event is handled.
+func (f *Fetcher) SubscribeBlockEventsRetry(
+	ctx context.Context,
+	network *types.NetworkIdentifier,
+	offset int64,
+	handler BlockEventHandler,
+) (fetchErr *Error) {
+	ctx, span := f.startSpan(ctx, "SubscribeBlockEventsRetry")
+	defer func() { endSpan(span, fetchErr) }()
+
+	retries := backoffRetries(
+		f.retryElapsedTime,
+		f.maxRetries,
+	)
+
+	for {
+		attemptCtx, attempt := f.startAttempt(ctx, "SubscribeBlockEventsRetry", retries)
+		next, err := f.SubscribeBlockEvents(attemptCtx, network, offset, handler)
+		endSpan(attempt, err)
+
+		if ctx.Err() != nil {
+			return &Error{
+				Err: ctx.Err(),
+			}
+		}
+
+		if is, _ := asserter.Err(err.Err); is {
+			fetcherErr := &Error{
+				Err:       fmt.Errorf("/events/blocks/stream not attempting retry: %w", err.Err),
+				ClientErr: err.ClientErr,
+			}
+			return fetcherErr
+		}
+
+		if next > offset {
+			offset = next
+			retries = backoffRetries(
+				f.retryElapsedTime,
+				f.maxRetries,
+			)
+		}
+
+		if err := tryAgain(
+			fmt.Sprintf("/events/blocks/stream %d", offset),
+			retries,
+			err,
+		); err != nil {
+			return err
+		}
+	}
+}
@@ -17,6 +17,7 @@ package fetcher
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -25,6 +26,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	"github.com/dominant-strategies/mesh-sdk-go/server"
 	"github.com/dominant-strategies/mesh-sdk-go/types"
 )
 
@@ -168,3 +171,111 @@ func TestEventsBlocksRetry(t *testing.T) {
 		})
 	}
 }
+
+// closingEventsServicer emits up to 2 events from
+// the requested offset before closing the stream.
+type closingEventsServicer struct {
+	events  []*types.BlockEvent
+	offsets []int64
+}
+
+func (s *closingEventsServicer) EventsBlocksStream(
+	ctx context.Context,
+	request *types.EventsBlocksRequest,
+	emit func(*types.BlockEvent) error,
+) *types.Error {
+	s.offsets = append(s.offsets, *request.Offset)
+	for _, event := range s.events[*request.Offset:min(*request.Offset+2, int64(len(s.events)))] {
+		if err := emit(event); err != nil {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func TestSubscribeBlockEventsRetry(t *testing.T) {
+	assert := assert.New(t)
+
+	events := make([]*types.BlockEvent, 5)
+	for i := range events {
+		events[i] = &types.BlockEvent{
+			Sequence: int64(i),
+			BlockIdentifier: &types.BlockIdentifier{
+				Index: int64(i),
+				Hash:  fmt.Sprintf("block %d", i),
+			},
+			Type: types.ADDED,
+		}
+	}
+
+	serverAsserter, err := asserter.NewServer(
+		[]string{"transfer"},
+		false,
+		[]*types.NetworkIdentifier{basicNetwork},
+		nil,
+		false,
+		"",
+	)
+	assert.NoError(err)
+
+	servicer := &closingEventsServicer{events: events}
+	ts := httptest.NewServer(server.NewRouter(
+		server.NewEventsStreamAPIController(servicer, serverAsserter),
+	))
+	defer ts.Close()
+
+	f := New(
+		ts.URL,
+		WithRetryElapsedTime(5*time.Second),
+		WithMaxRetries(2),
+	)
+
+	// Subscriptions resume from the last handled
+	// event when the server closes the stream.
+	errDone := errors.New("done")
+	var handled []*types.BlockEvent
+	fetchErr := f.SubscribeBlockEventsRetry(
+		context.Background(),
+		basicNetwork,
+		0,
+		func(ctx context.Context, event *types.BlockEvent) error {
+			handled = append(handled, event)
+			if len(handled) == len(events) {
+				return errDone
+			}
+
+			return nil
+		},
+	)
+	assert.True(errors.Is(fetchErr.Err, errDone))
+	assert.Equal(events, handled)
+	assert.Equal([]int64{0, 2, 4}, servicer.offsets)
+
+	// Retries are exhausted if the stream is
+	// closed without any new events.
+	servicer.offsets = nil
+	lastSequence := int64(len(events))
+	_, fetchErr = f.SubscribeBlockEvents(
+		context.Background(),
+		basicNetwork,
+		lastSequence,
+		func(ctx context.Context, event *types.BlockEvent) error {
+			return nil
+		},
+	)
+	assert.True(errors.Is(fetchErr.Err, ErrBlockEventStreamClosed))
+	assert.True(fetchErr.Retry)
+
+	fetchErr = f.SubscribeBlockEventsRetry(
+		context.Background(),
+		basicNetwork,
+		lastSequence,
+		func(ctx context.Context, event *types.BlockEvent) error {
+			return nil
+		},
+	)
+	assert.True(errors.Is(fetchErr.Err, ErrExhaustedRetries))
+	assert.True(errors.Is(fetchErr.Err, ErrBlockEventStreamClosed))
+	assert.Equal([]int64{5, 5, 5, 5}, servicer.offsets)
+}
@@ -17,12 +17,14 @@ package fetcher
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/fatih/color"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/semaphore"
 
 	"github.com/dominant-strategies/mesh-sdk-go/asserter"
@@ -70,14 +72,41 @@ type Fetcher struct {
 	// be applied.
 	Asserter         *asserter.Asserter
 	rosettaClient    *client.APIClient
+	meshClient       MeshClient
 	maxConnections   int
 	maxRetries       uint64
 	retryElapsedTime time.Duration
 	insecureTLS      bool
+	certificates     []tls.Certificate
+	rootCAs          *x509.CertPool
 	forceRetry       bool
 	httpTimeout      time.Duration
 	metaData         string
 
+	// endpointPool routes requests to one of many
+	// Rosetta servers (if populated).
+	endpointPool *EndpointPool
+
+	// limiter applies rate and adaptive concurrency
+	// limits to each endpoint (if configured).
+	limiter *limitedTransport
+
+	// auth adds credentials to each
+	// request (if configured).
+	auth *authTransport
+
+	// responseCache stores immutable
+	// responses (if populated).
+	responseCache *ResponseCache
+
+	// errorCodes classifies errors returned
+	// by the server (if populated).
+	errorCodes *ErrorCodes
+
+	// tracerProvider is used to create spans
+	// (if populated).
+	tracerProvider trace.TracerProvider
+
 	// connectionSemaphore is used to limit the
 	// number of concurrent requests we make.
 	connectionSemaphore *semaphore.Weighted
@@ -93,6 +122,8 @@ func New(
 		maxRetries:       DefaultRetries,
 		retryElapsedTime: DefaultElapsedTime,
 		httpTimeout:      DefaultHTTPTimeout,
+		limiter:          &limitedTransport{},
+		auth:             &authTransport{},
 	}
 
 	// Override defaults with any provided options
@@ -109,9 +140,41 @@ func New(
 		defaultTransport.IdleConnTimeout = DefaultIdleConnTimeout
 		defaultTransport.MaxIdleConns = f.maxConnections
 		defaultTransport.MaxIdleConnsPerHost = DefaultMaxConnections
+
+		if f.insecureTLS || len(f.certificates) > 0 || f.rootCAs != nil {
+			defaultTransport.TLSClientConfig = &tls.Config{
+				InsecureSkipVerify: f.insecureTLS, // #nosec G402
+				Certificates:       f.certificates,
+				RootCAs:            f.rootCAs,
+			}
+		}
+
+		var transport http.RoundTripper = defaultTransport
+		if f.auth.configured() {
+			f.auth.transport = transport
+			transport = f.auth
+		}
+
+		if f.limiter.rate > 0 || f.limiter.adaptiveMax > 0 {
+			f.limiter.transport = transport
+			transport = f.limiter
+		}
+
+		if f.endpointPool != nil {
+			if f.endpointPool.transport == nil {
+				f.endpointPool.transport = transport
+			}
+
+			if len(serverAddress) == 0 {
+				serverAddress = f.endpointPool.Address()
+			}
+
+			transport = f.endpointPool
+		}
+
 		defaultHTTPClient := &http.Client{
 			Timeout:   f.httpTimeout,
-			Transport: defaultTransport,
+			Transport: transport,
 		}
 
 		// Create default fetcher
@@ -120,12 +183,26 @@ func New(
 			DefaultUserAgent,
 			defaultHTTPClient,
 		)
+		clientCfg.TracerProvider = f.tracerProvider
 		f.rosettaClient = client.NewAPIClient(clientCfg)
 	}
 
+	if f.meshClient == nil {
+		f.meshClient = &apiMeshClient{client: f.rosettaClient}
+	}
+
 	if f.insecureTLS {
-		if transport, ok := f.rosettaClient.GetConfig().HTTPClient.Transport.(*http.Transport); ok {
-			transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // #nosec G402
+		transport := f.rosettaClient.GetConfig().HTTPClient.Transport
+		if pool, ok := transport.(*EndpointPool); ok {
+			transport = pool.transport
+		}
+
+		if transport, ok := transport.(*http.Transport); ok {
+			if transport.TLSClientConfig == nil {
+				transport.TLSClientConfig = &tls.Config{}
+			}
+
+			transport.TLSClientConfig.InsecureSkipVerify = true // #nosec G402
 		}
 	}
 
@@ -135,6 +212,14 @@ func New(
 	return f
 }
 
+// ConcurrencyLimits returns the current adaptive concurrency
+// limit of each endpoint (keyed by host) that has been
+// requested. It is empty if WithAdaptiveConcurrency was not
+// provided.
+func (f *Fetcher) ConcurrencyLimits() map[string]int {
+	return f.limiter.limits()
+}
+
 // InitializeAsserter creates an Asserter for
 // validating responses. The Asserter is created
 // by fetching the NetworkStatus and NetworkOptions
@@ -208,6 +293,10 @@ func (f *Fetcher) InitializeAsserter(
 		return nil, nil, err
 	}
 
+	if err := f.errorCodes.declared(networkOptions); err != nil {
+		return nil, nil, &Error{Err: err}
+	}
+
 	newAsserter, assertErr := asserter.NewClientWithResponses(
 		primaryNetwork,
 		networkStatus,
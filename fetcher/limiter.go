@@ -0,0 +1,304 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultAdaptiveDecrease is the factor the concurrency
+	// limit of an endpoint is multiplied by when it is overloaded.
+	DefaultAdaptiveDecrease = 0.5
+)
+
+// tokenBucket limits the rate of requests to an
+// endpoint. Tokens are added at rate per second, up
+// to burst tokens.
+type tokenBucket struct {
+	mutex  sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve removes a token from the bucket and returns
+// how long the caller must wait before using it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// wait blocks until a token is available or
+// the context is canceled.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	delay := b.reserve()
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// requestOutcome is used by the adaptiveLimiter
+// to adjust its concurrency limit.
+type requestOutcome int
+
+const (
+	requestSucceeded requestOutcome = iota
+	requestOverloaded
+	requestIgnored
+)
+
+// adaptiveLimiter limits the number of concurrent requests
+// to an endpoint. The limit is increased by 1 after a full
+// limit of successful requests and is multiplied by
+// DefaultAdaptiveDecrease each time a request indicates the
+// endpoint is overloaded (additive increase, multiplicative
+// decrease).
+type adaptiveLimiter struct {
+	mutex    sync.Mutex
+	limit    float64
+	min      float64
+	max      float64
+	inFlight int
+
+	// released is closed (and replaced) each time
+	// a request is released to wake any waiters.
+	released chan struct{}
+}
+
+// newAdaptiveLimiter creates a new *adaptiveLimiter. The
+// limits are clamped so that 1 <= min <= initial <= max
+// (otherwise, acquire could block forever).
+func newAdaptiveLimiter(initial int, min int, max int) *adaptiveLimiter {
+	if min < 1 {
+		min = 1
+	}
+
+	if max < min {
+		max = min
+	}
+
+	if initial < min {
+		initial = min
+	}
+
+	if initial > max {
+		initial = max
+	}
+
+	return &adaptiveLimiter{
+		limit:    float64(initial),
+		min:      float64(min),
+		max:      float64(max),
+		released: make(chan struct{}),
+	}
+}
+
+// acquire blocks until a request can be made
+// or the context is canceled.
+func (l *adaptiveLimiter) acquire(ctx context.Context) error {
+	for {
+		l.mutex.Lock()
+		if l.inFlight < int(l.limit) {
+			l.inFlight++
+			l.mutex.Unlock()
+			return nil
+		}
+		released := l.released
+		l.mutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+// release returns a request slot and adjusts the
+// limit based on the outcome of the request.
+func (l *adaptiveLimiter) release(outcome requestOutcome) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.inFlight--
+	switch outcome {
+	case requestSucceeded:
+		l.limit = math.Min(l.max, l.limit+1/l.limit)
+	case requestOverloaded:
+		l.limit = math.Max(l.min, l.limit*DefaultAdaptiveDecrease)
+	}
+
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+func (l *adaptiveLimiter) getLimit() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return int(l.limit)
+}
+
+// endpointLimiter contains the limits
+// applied to a single endpoint.
+type endpointLimiter struct {
+	bucket   *tokenBucket
+	adaptive *adaptiveLimiter
+}
+
+// limitedTransport is an http.RoundTripper that applies
+// a token bucket and/or an adaptive concurrency limit to
+// each endpoint (keyed by host).
+type limitedTransport struct {
+	transport http.RoundTripper
+
+	// rate <= 0 disables the token bucket.
+	rate  float64
+	burst int
+
+	// adaptiveMax <= 0 disables the adaptive
+	// concurrency limit.
+	adaptiveInitial int
+	adaptiveMin     int
+	adaptiveMax     int
+
+	mutex     sync.Mutex
+	endpoints map[string]*endpointLimiter
+}
+
+func (t *limitedTransport) endpoint(host string) *endpointLimiter {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.endpoints == nil {
+		t.endpoints = map[string]*endpointLimiter{}
+	}
+
+	e, ok := t.endpoints[host]
+	if ok {
+		return e
+	}
+
+	e = &endpointLimiter{}
+	if t.rate > 0 {
+		e.bucket = newTokenBucket(t.rate, t.burst)
+	}
+	if t.adaptiveMax > 0 {
+		e.adaptive = newAdaptiveLimiter(t.adaptiveInitial, t.adaptiveMin, t.adaptiveMax)
+	}
+	t.endpoints[host] = e
+
+	return e
+}
+
+// limits returns the current adaptive concurrency
+// limit of each endpoint.
+func (t *limitedTransport) limits() map[string]int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	limits := map[string]int{}
+	for host, e := range t.endpoints {
+		if e.adaptive != nil {
+			limits[host] = e.adaptive.getLimit()
+		}
+	}
+
+	return limits
+}
+
+// overloaded returns a boolean indicating if the result
+// of a request indicates the endpoint is overloaded (it
+// timed out or the response is transient). Non-retriable
+// errors are valid responses and do not shrink the limit.
+func overloaded(resp *http.Response, err error) bool {
+	if err != nil {
+		var netErr net.Error
+		return errors.Is(err, context.DeadlineExceeded) ||
+			(errors.As(err, &netErr) && netErr.Timeout())
+	}
+
+	return retriableResponse(resp)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	e := t.endpoint(req.URL.Host)
+	ctx := req.Context()
+
+	if e.bucket != nil {
+		if err := e.bucket.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if e.adaptive == nil {
+		return t.transport.RoundTrip(req)
+	}
+
+	if err := e.adaptive.acquire(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	switch {
+	case overloaded(resp, err):
+		e.adaptive.release(requestOverloaded)
+	case err != nil:
+		e.adaptive.release(requestIgnored)
+	default:
+		e.adaptive.release(requestSucceeded)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,224 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+func TestWithRateLimit(t *testing.T) {
+	var (
+		assert = assert.New(t)
+		ctx    = context.Background()
+		calls  = 0
+	)
+
+	ts := newEndpointServer(t, http.StatusOK, basicNetworkStatus, &calls)
+	defer ts.Close()
+
+	f := New(ts.URL, WithRateLimit(20, 1))
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		_, err := f.NetworkStatusRetry(ctx, basicNetwork, nil)
+		assert.Nil(err)
+	}
+
+	// The first request uses the burst token and each
+	// remaining request waits 50ms for a new token.
+	assert.GreaterOrEqual(time.Since(start), 190*time.Millisecond)
+	assert.Equal(5, calls)
+	assert.Empty(f.ConcurrencyLimits())
+}
+
+func TestWithAdaptiveConcurrency(t *testing.T) {
+	var (
+		assert   = assert.New(t)
+		ctx      = context.Background()
+		mutex    sync.Mutex
+		failures = 2
+	)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintln(w, types.PrettyPrintStruct(&types.Error{
+				Retriable: true,
+			}))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, types.PrettyPrintStruct(basicNetworkStatus))
+	}))
+	defer ts.Close()
+
+	parsed, err := url.Parse(ts.URL)
+	assert.NoError(err)
+
+	f := New(
+		ts.URL,
+		WithRetryElapsedTime(5*time.Second),
+		WithAdaptiveConcurrency(8, 1, 10),
+	)
+
+	// Both failures halve the limit (8 -> 4 -> 2) and the
+	// final success increases it by 1/2.
+	_, fetchErr := f.NetworkStatusRetry(ctx, basicNetwork, nil)
+	assert.Nil(fetchErr)
+	assert.Equal(map[string]int{parsed.Host: 2}, f.ConcurrencyLimits())
+
+	// 2 successful requests at a limit of 2 increase
+	// the limit by 1.
+	for i := 0; i < 2; i++ {
+		_, fetchErr = f.NetworkStatusRetry(ctx, basicNetwork, nil)
+		assert.Nil(fetchErr)
+	}
+	assert.Equal(map[string]int{parsed.Host: 3}, f.ConcurrencyLimits())
+}
+
+func TestAdaptiveConcurrencyNonRetriableError(t *testing.T) {
+	var (
+		assert = assert.New(t)
+		ctx    = context.Background()
+		calls  = 0
+	)
+
+	ts := newEndpointErrorServer(t, &types.Error{Code: 2, Message: "account not found"}, &calls)
+	defer ts.Close()
+
+	parsed, err := url.Parse(ts.URL)
+	assert.NoError(err)
+
+	f := New(ts.URL, WithAdaptiveConcurrency(8, 1, 10))
+
+	// Non-retriable errors do not indicate
+	// the endpoint is overloaded.
+	for i := 0; i < 2; i++ {
+		_, fetchErr := f.NetworkStatus(ctx, basicNetwork, nil)
+		assert.NotNil(fetchErr)
+	}
+	assert.Equal(2, calls)
+	assert.Equal(map[string]int{parsed.Host: 8}, f.ConcurrencyLimits())
+}
+
+func TestAdaptiveLimiter(t *testing.T) {
+	var (
+		assert = assert.New(t)
+		ctx    = context.Background()
+	)
+
+	l := newAdaptiveLimiter(1, 1, 2)
+	assert.NoError(l.acquire(ctx))
+
+	// The limit is reached, so acquire blocks
+	// until the context is canceled.
+	canceledCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(l.acquire(canceledCtx), context.DeadlineExceeded)
+
+	// A blocked acquire succeeds once a
+	// request is released.
+	acquired := make(chan error)
+	go func() {
+		acquired <- l.acquire(ctx)
+	}()
+	l.release(requestIgnored)
+	assert.NoError(<-acquired)
+	assert.Equal(1, l.getLimit())
+
+	l.release(requestSucceeded)
+	assert.Equal(2, l.getLimit())
+
+	l.inFlight++
+	l.release(requestOverloaded)
+	assert.Equal(1, l.getLimit())
+}
+
+func TestAdaptiveLimiterBounds(t *testing.T) {
+	var tests = map[string]struct {
+		initial int
+		min     int
+		max     int
+
+		expectedLimit int
+		expectedMin   float64
+		expectedMax   float64
+	}{
+		"valid": {
+			initial:       2,
+			min:           1,
+			max:           4,
+			expectedLimit: 2,
+			expectedMin:   1,
+			expectedMax:   4,
+		},
+		"zero min and initial": {
+			initial:       0,
+			min:           0,
+			max:           4,
+			expectedLimit: 1,
+			expectedMin:   1,
+			expectedMax:   4,
+		},
+		"initial above max": {
+			initial:       10,
+			min:           1,
+			max:           4,
+			expectedLimit: 4,
+			expectedMin:   1,
+			expectedMax:   4,
+		},
+		"min above max": {
+			initial:       2,
+			min:           5,
+			max:           3,
+			expectedLimit: 5,
+			expectedMin:   5,
+			expectedMax:   5,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			l := newAdaptiveLimiter(test.initial, test.min, test.max)
+			assert.Equal(t, test.expectedLimit, l.getLimit())
+			assert.Equal(t, test.expectedMin, l.min)
+			assert.Equal(t, test.expectedMax, l.max)
+
+			// acquire never blocks when nothing
+			// is in flight.
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			assert.NoError(t, l.acquire(ctx))
+		})
+	}
+}
@@ -35,7 +35,7 @@ func (f *Fetcher) Mempool(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	response, clientErr, err := f.rosettaClient.MempoolAPI.Mempool(
+	response, clientErr, err := f.meshClient.Mempool(
 		ctx,
 		&types.NetworkRequest{
 			NetworkIdentifier: network,
@@ -70,7 +70,7 @@ func (f *Fetcher) MempoolTransaction(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	response, clientErr, err := f.rosettaClient.MempoolAPI.MempoolTransaction(
+	response, clientErr, err := f.meshClient.MempoolTransaction(
 		ctx,
 		&types.MempoolTransactionRequest{
 			NetworkIdentifier:     network,
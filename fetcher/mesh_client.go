@@ -0,0 +1,295 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+
+	"github.com/dominant-strategies/mesh-sdk-go/client"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// MeshClient invokes the (non-streaming) Mesh API endpoints
+// used by a Fetcher. A *meshgrpc.Client implements MeshClient,
+// so it can be provided with WithMeshClient to fetch over gRPC
+// without encoding requests and responses as JSON.
+type MeshClient interface {
+	AccountBalance(
+		ctx context.Context,
+		request *types.AccountBalanceRequest,
+	) (*types.AccountBalanceResponse, *types.Error, error)
+
+	AccountCoins(
+		ctx context.Context,
+		request *types.AccountCoinsRequest,
+	) (*types.AccountCoinsResponse, *types.Error, error)
+
+	Block(
+		ctx context.Context,
+		request *types.BlockRequest,
+	) (*types.BlockResponse, *types.Error, error)
+
+	BlockTransaction(
+		ctx context.Context,
+		request *types.BlockTransactionRequest,
+	) (*types.BlockTransactionResponse, *types.Error, error)
+
+	Call(
+		ctx context.Context,
+		request *types.CallRequest,
+	) (*types.CallResponse, *types.Error, error)
+
+	ConstructionCombine(
+		ctx context.Context,
+		request *types.ConstructionCombineRequest,
+	) (*types.ConstructionCombineResponse, *types.Error, error)
+
+	ConstructionDerive(
+		ctx context.Context,
+		request *types.ConstructionDeriveRequest,
+	) (*types.ConstructionDeriveResponse, *types.Error, error)
+
+	ConstructionHash(
+		ctx context.Context,
+		request *types.ConstructionHashRequest,
+	) (*types.TransactionIdentifierResponse, *types.Error, error)
+
+	ConstructionMetadata(
+		ctx context.Context,
+		request *types.ConstructionMetadataRequest,
+	) (*types.ConstructionMetadataResponse, *types.Error, error)
+
+	ConstructionParse(
+		ctx context.Context,
+		request *types.ConstructionParseRequest,
+	) (*types.ConstructionParseResponse, *types.Error, error)
+
+	ConstructionPayloads(
+		ctx context.Context,
+		request *types.ConstructionPayloadsRequest,
+	) (*types.ConstructionPayloadsResponse, *types.Error, error)
+
+	ConstructionPreprocess(
+		ctx context.Context,
+		request *types.ConstructionPreprocessRequest,
+	) (*types.ConstructionPreprocessResponse, *types.Error, error)
+
+	ConstructionSubmit(
+		ctx context.Context,
+		request *types.ConstructionSubmitRequest,
+	) (*types.TransactionIdentifierResponse, *types.Error, error)
+
+	EventsBlocks(
+		ctx context.Context,
+		request *types.EventsBlocksRequest,
+	) (*types.EventsBlocksResponse, *types.Error, error)
+
+	Mempool(
+		ctx context.Context,
+		request *types.NetworkRequest,
+	) (*types.MempoolResponse, *types.Error, error)
+
+	MempoolTransaction(
+		ctx context.Context,
+		request *types.MempoolTransactionRequest,
+	) (*types.MempoolTransactionResponse, *types.Error, error)
+
+	NetworkList(
+		ctx context.Context,
+		request *types.MetadataRequest,
+	) (*types.NetworkListResponse, *types.Error, error)
+
+	NetworkOptions(
+		ctx context.Context,
+		request *types.NetworkRequest,
+	) (*types.NetworkOptionsResponse, *types.Error, error)
+
+	NetworkStatus(
+		ctx context.Context,
+		request *types.NetworkRequest,
+	) (*types.NetworkStatusResponse, *types.Error, error)
+
+	SearchTransactions(
+		ctx context.Context,
+		request *types.SearchTransactionsRequest,
+	) (*types.SearchTransactionsResponse, *types.Error, error)
+}
+
+// apiMeshClient is the MeshClient used when WithMeshClient
+// is not provided. It invokes each endpoint with a
+// *client.APIClient.
+type apiMeshClient struct {
+	client *client.APIClient
+}
+
+// AccountBalance implements MeshClient.
+func (c *apiMeshClient) AccountBalance(
+	ctx context.Context,
+	request *types.AccountBalanceRequest,
+) (*types.AccountBalanceResponse, *types.Error, error) {
+	return c.client.AccountAPI.AccountBalance(ctx, request)
+}
+
+// AccountCoins implements MeshClient.
+func (c *apiMeshClient) AccountCoins(
+	ctx context.Context,
+	request *types.AccountCoinsRequest,
+) (*types.AccountCoinsResponse, *types.Error, error) {
+	return c.client.AccountAPI.AccountCoins(ctx, request)
+}
+
+// Block implements MeshClient.
+func (c *apiMeshClient) Block(
+	ctx context.Context,
+	request *types.BlockRequest,
+) (*types.BlockResponse, *types.Error, error) {
+	return c.client.BlockAPI.Block(ctx, request)
+}
+
+// BlockTransaction implements MeshClient.
+func (c *apiMeshClient) BlockTransaction(
+	ctx context.Context,
+	request *types.BlockTransactionRequest,
+) (*types.BlockTransactionResponse, *types.Error, error) {
+	return c.client.BlockAPI.BlockTransaction(ctx, request)
+}
+
+// Call implements MeshClient.
+func (c *apiMeshClient) Call(
+	ctx context.Context,
+	request *types.CallRequest,
+) (*types.CallResponse, *types.Error, error) {
+	return c.client.CallAPI.Call(ctx, request)
+}
+
+// ConstructionCombine implements MeshClient.
+func (c *apiMeshClient) ConstructionCombine(
+	ctx context.Context,
+	request *types.ConstructionCombineRequest,
+) (*types.ConstructionCombineResponse, *types.Error, error) {
+	return c.client.ConstructionAPI.ConstructionCombine(ctx, request)
+}
+
+// ConstructionDerive implements MeshClient.
+func (c *apiMeshClient) ConstructionDerive(
+	ctx context.Context,
+	request *types.ConstructionDeriveRequest,
+) (*types.ConstructionDeriveResponse, *types.Error, error) {
+	return c.client.ConstructionAPI.ConstructionDerive(ctx, request)
+}
+
+// ConstructionHash implements MeshClient.
+func (c *apiMeshClient) ConstructionHash(
+	ctx context.Context,
+	request *types.ConstructionHashRequest,
+) (*types.TransactionIdentifierResponse, *types.Error, error) {
+	return c.client.ConstructionAPI.ConstructionHash(ctx, request)
+}
+
+// ConstructionMetadata implements MeshClient.
+func (c *apiMeshClient) ConstructionMetadata(
+	ctx context.Context,
+	request *types.ConstructionMetadataRequest,
+) (*types.ConstructionMetadataResponse, *types.Error, error) {
+	return c.client.ConstructionAPI.ConstructionMetadata(ctx, request)
+}
+
+// ConstructionParse implements MeshClient.
+func (c *apiMeshClient) ConstructionParse(
+	ctx context.Context,
+	request *types.ConstructionParseRequest,
+) (*types.ConstructionParseResponse, *types.Error, error) {
+	return c.client.ConstructionAPI.ConstructionParse(ctx, request)
+}
+
+// ConstructionPayloads implements MeshClient.
+func (c *apiMeshClient) ConstructionPayloads(
+	ctx context.Context,
+	request *types.ConstructionPayloadsRequest,
+) (*types.ConstructionPayloadsResponse, *types.Error, error) {
+	return c.client.ConstructionAPI.ConstructionPayloads(ctx, request)
+}
+
+// ConstructionPreprocess implements MeshClient.
+func (c *apiMeshClient) ConstructionPreprocess(
+	ctx context.Context,
+	request *types.ConstructionPreprocessRequest,
+) (*types.ConstructionPreprocessResponse, *types.Error, error) {
+	return c.client.ConstructionAPI.ConstructionPreprocess(ctx, request)
+}
+
+// ConstructionSubmit implements MeshClient.
+func (c *apiMeshClient) ConstructionSubmit(
+	ctx context.Context,
+	request *types.ConstructionSubmitRequest,
+) (*types.TransactionIdentifierResponse, *types.Error, error) {
+	return c.client.ConstructionAPI.ConstructionSubmit(ctx, request)
+}
+
+// EventsBlocks implements MeshClient.
+func (c *apiMeshClient) EventsBlocks(
+	ctx context.Context,
+	request *types.EventsBlocksRequest,
+) (*types.EventsBlocksResponse, *types.Error, error) {
+	return c.client.EventsAPI.EventsBlocks(ctx, request)
+}
+
+// Mempool implements MeshClient.
+func (c *apiMeshClient) Mempool(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.MempoolResponse, *types.Error, error) {
+	return c.client.MempoolAPI.Mempool(ctx, request)
+}
+
+// MempoolTransaction implements MeshClient.
+func (c *apiMeshClient) MempoolTransaction(
+	ctx context.Context,
+	request *types.MempoolTransactionRequest,
+) (*types.MempoolTransactionResponse, *types.Error, error) {
+	return c.client.MempoolAPI.MempoolTransaction(ctx, request)
+}
+
+// NetworkList implements MeshClient.
+func (c *apiMeshClient) NetworkList(
+	ctx context.Context,
+	request *types.MetadataRequest,
+) (*types.NetworkListResponse, *types.Error, error) {
+	return c.client.NetworkAPI.NetworkList(ctx, request)
+}
+
+// NetworkOptions implements MeshClient.
+func (c *apiMeshClient) NetworkOptions(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.NetworkOptionsResponse, *types.Error, error) {
+	return c.client.NetworkAPI.NetworkOptions(ctx, request)
+}
+
+// NetworkStatus implements MeshClient.
+func (c *apiMeshClient) NetworkStatus(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.NetworkStatusResponse, *types.Error, error) {
+	return c.client.NetworkAPI.NetworkStatus(ctx, request)
+}
+
+// SearchTransactions implements MeshClient.
+func (c *apiMeshClient) SearchTransactions(
+	ctx context.Context,
+	request *types.SearchTransactionsRequest,
+) (*types.SearchTransactionsResponse, *types.Error, error) {
+	return c.client.SearchAPI.SearchTransactions(ctx, request)
+}
@@ -36,7 +36,7 @@ func (f *Fetcher) NetworkStatus(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	networkStatus, clientErr, err := f.rosettaClient.NetworkAPI.NetworkStatus(
+	networkStatus, clientErr, err := f.meshClient.NetworkStatus(
 		ctx,
 		&types.NetworkRequest{
 			NetworkIdentifier: network,
@@ -63,18 +63,23 @@ func (f *Fetcher) NetworkStatusRetry(
 	ctx context.Context,
 	network *types.NetworkIdentifier,
 	metadata map[string]interface{},
-) (*types.NetworkStatusResponse, *Error) {
+) (_ *types.NetworkStatusResponse, fetchErr *Error) {
+	ctx, span := f.startSpan(ctx, "NetworkStatusRetry")
+	defer func() { endSpan(span, fetchErr) }()
+
 	backoffRetries := backoffRetries(
 		f.retryElapsedTime,
 		f.maxRetries,
 	)
 
 	for {
+		attemptCtx, attempt := f.startAttempt(ctx, "NetworkStatusRetry", backoffRetries)
 		networkStatus, err := f.NetworkStatus(
-			ctx,
+			attemptCtx,
 			network,
 			metadata,
 		)
+		endSpan(attempt, err)
 		if err == nil {
 			return networkStatus, nil
 		}
@@ -116,7 +121,7 @@ func (f *Fetcher) NetworkList(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	networkList, clientErr, err := f.rosettaClient.NetworkAPI.NetworkList(
+	networkList, clientErr, err := f.meshClient.NetworkList(
 		ctx,
 		&types.MetadataRequest{
 			Metadata: metadata,
@@ -142,17 +147,22 @@ func (f *Fetcher) NetworkList(
 func (f *Fetcher) NetworkListRetry(
 	ctx context.Context,
 	metadata map[string]interface{},
-) (*types.NetworkListResponse, *Error) {
+) (_ *types.NetworkListResponse, fetchErr *Error) {
+	ctx, span := f.startSpan(ctx, "NetworkListRetry")
+	defer func() { endSpan(span, fetchErr) }()
+
 	backoffRetries := backoffRetries(
 		f.retryElapsedTime,
 		f.maxRetries,
 	)
 
 	for {
+		attemptCtx, attempt := f.startAttempt(ctx, "NetworkListRetry", backoffRetries)
 		networkList, err := f.NetworkList(
-			ctx,
+			attemptCtx,
 			metadata,
 		)
+		endSpan(attempt, err)
 		if err == nil {
 			return networkList, nil
 		}
@@ -191,7 +201,7 @@ func (f *Fetcher) NetworkOptions(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	networkOptions, clientErr, err := f.rosettaClient.NetworkAPI.NetworkOptions(
+	networkOptions, clientErr, err := f.meshClient.NetworkOptions(
 		ctx,
 		&types.NetworkRequest{
 			NetworkIdentifier: network,
@@ -219,18 +229,23 @@ func (f *Fetcher) NetworkOptionsRetry(
 	ctx context.Context,
 	network *types.NetworkIdentifier,
 	metadata map[string]interface{},
-) (*types.NetworkOptionsResponse, *Error) {
+) (_ *types.NetworkOptionsResponse, fetchErr *Error) {
+	ctx, span := f.startSpan(ctx, "NetworkOptionsRetry")
+	defer func() { endSpan(span, fetchErr) }()
+
 	backoffRetries := backoffRetries(
 		f.retryElapsedTime,
 		f.maxRetries,
 	)
 
 	for {
+		attemptCtx, attempt := f.startAttempt(ctx, "NetworkOptionsRetry", backoffRetries)
 		networkOptions, err := f.NetworkOptions(
-			ctx,
+			attemptCtx,
 			network,
 			metadata,
 		)
+		endSpan(attempt, err)
 		if err == nil {
 			return networkOptions, nil
 		}
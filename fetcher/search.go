@@ -35,7 +35,7 @@ func (f *Fetcher) SearchTransactions(
 	}
 	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
 
-	response, clientErr, err := f.rosettaClient.SearchAPI.SearchTransactions(ctx, request)
+	response, clientErr, err := f.meshClient.SearchTransactions(ctx, request)
 	if err != nil {
 		return nil, nil, f.RequestFailedError(clientErr, err, "/search/transactions")
 	}
@@ -60,17 +60,22 @@ func (f *Fetcher) SearchTransactions(
 func (f *Fetcher) SearchTransactionsRetry(
 	ctx context.Context,
 	request *types.SearchTransactionsRequest,
-) (*int64, []*types.BlockTransaction, *Error) {
+) (_ *int64, _ []*types.BlockTransaction, fetchErr *Error) {
+	ctx, span := f.startSpan(ctx, "SearchTransactionsRetry")
+	defer func() { endSpan(span, fetchErr) }()
+
 	backoffRetries := backoffRetries(
 		f.retryElapsedTime,
 		f.maxRetries,
 	)
 
 	for {
+		attemptCtx, attempt := f.startAttempt(ctx, "SearchTransactionsRetry", backoffRetries)
 		nextOffset, transactions, err := f.SearchTransactions(
-			ctx,
+			attemptCtx,
 			request,
 		)
+		endSpan(attempt, err)
 		if err == nil {
 			return nextOffset, transactions, nil
 		}
@@ -0,0 +1,473 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/fatih/color"
+
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// maxPendingStreamTransactions is the number of transactions
+// StreamBlock holds while waiting for the block header.
+const maxPendingStreamTransactions = 1000
+
+// TransactionHandler is invoked by StreamBlock with each
+// validated transaction in a block (in the order they appear
+// in the block). Returning an error stops the stream.
+type TransactionHandler func(
+	ctx context.Context,
+	block *types.BlockIdentifier,
+	transaction *types.Transaction,
+) error
+
+// blockStreamDecoder incrementally decodes a /block response,
+// passing each transaction to a TransactionHandler instead of
+// holding all of them in memory.
+type blockStreamDecoder struct {
+	f       *Fetcher
+	dec     *json.Decoder
+	handler TransactionHandler
+
+	block        *types.Block
+	sawTimestamp bool
+	validated    bool
+
+	// seen contains the hash of each handled transaction
+	// (to detect duplicates without holding transactions).
+	seen map[string]struct{}
+
+	// pending holds transactions that arrive before the
+	// block header (which must be validated before any
+	// transaction is handled). It holds at most
+	// maxPendingStreamTransactions transactions.
+	pending []*types.Transaction
+}
+
+// expectDelim reads the next token and errors if it is
+// not the provided json.Delim.
+func (d *blockStreamDecoder) expectDelim(delim json.Delim) error {
+	token, err := d.dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if got, ok := token.(json.Delim); !ok || got != delim {
+		return fmt.Errorf("expected %s but found %v", delim, token)
+	}
+
+	return nil
+}
+
+// key reads the next object key.
+func (d *blockStreamDecoder) key() (string, error) {
+	token, err := d.dec.Token()
+	if err != nil {
+		return "", err
+	}
+
+	key, ok := token.(string)
+	if !ok {
+		return "", fmt.Errorf("expected object key but found %v", token)
+	}
+
+	return key, nil
+}
+
+// skip discards the next value.
+func (d *blockStreamDecoder) skip() error {
+	var discard json.RawMessage
+	return d.dec.Decode(&discard)
+}
+
+// decode reads a /block response, returning the block
+// without transactions (nil if the block was omitted)
+// and the identifiers of any other_transactions.
+func (d *blockStreamDecoder) decode(
+	ctx context.Context,
+) (*types.Block, []*types.TransactionIdentifier, error) {
+	if err := d.expectDelim('{'); err != nil {
+		return nil, nil, err
+	}
+
+	var otherTransactions []*types.TransactionIdentifier
+	for d.dec.More() {
+		key, err := d.key()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch key {
+		case "block":
+			if err := d.decodeBlock(ctx); err != nil {
+				return nil, nil, err
+			}
+		case "other_transactions":
+			if err := d.dec.Decode(&otherTransactions); err != nil {
+				return nil, nil, err
+			}
+		default:
+			if err := d.skip(); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if err := d.expectDelim('}'); err != nil {
+		return nil, nil, err
+	}
+
+	return d.block, otherTransactions, nil
+}
+
+// decodeBlock reads the block object, handling each
+// transaction as soon as it is decoded.
+func (d *blockStreamDecoder) decodeBlock(ctx context.Context) error {
+	token, err := d.dec.Token()
+	if err != nil {
+		return err
+	}
+
+	// A block may be omitted by returning null.
+	if token == nil {
+		return nil
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("expected block object but found %v", token)
+	}
+
+	d.block = &types.Block{}
+	for d.dec.More() {
+		key, err := d.key()
+		if err != nil {
+			return err
+		}
+
+		switch key {
+		case "block_identifier":
+			err = d.dec.Decode(&d.block.BlockIdentifier)
+		case "parent_block_identifier":
+			err = d.dec.Decode(&d.block.ParentBlockIdentifier)
+		case "timestamp":
+			d.sawTimestamp = true
+			err = d.dec.Decode(&d.block.Timestamp)
+		case "metadata":
+			err = d.dec.Decode(&d.block.Metadata)
+		case "transactions":
+			err = d.decodeTransactions(ctx)
+		default:
+			err = d.skip()
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := d.expectDelim('}'); err != nil {
+		return err
+	}
+
+	// Validate the header (if not already validated)
+	// and handle any transactions that preceded it.
+	if !d.validated {
+		if err := d.validateHeader(); err != nil {
+			return err
+		}
+	}
+
+	for _, transaction := range d.pending {
+		if err := d.handle(ctx, transaction); err != nil {
+			return err
+		}
+	}
+	d.pending = nil
+
+	return nil
+}
+
+// decodeTransactions reads the transactions array one
+// transaction at a time.
+func (d *blockStreamDecoder) decodeTransactions(ctx context.Context) error {
+	token, err := d.dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if token == nil {
+		return nil
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected transactions array but found %v", token)
+	}
+
+	for d.dec.More() {
+		var transaction types.Transaction
+		if err := d.dec.Decode(&transaction); err != nil {
+			return err
+		}
+
+		if !d.validated {
+			if !d.headerComplete() {
+				if len(d.pending) >= maxPendingStreamTransactions {
+					return fmt.Errorf(
+						"%w: %d transactions",
+						ErrBlockStreamHeaderMissing,
+						maxPendingStreamTransactions,
+					)
+				}
+
+				d.pending = append(d.pending, &transaction)
+				continue
+			}
+
+			if err := d.validateHeader(); err != nil {
+				return err
+			}
+		}
+
+		if err := d.handle(ctx, &transaction); err != nil {
+			return err
+		}
+	}
+
+	return d.expectDelim(']')
+}
+
+// headerComplete returns a boolean indicating if all
+// fields required to validate the block header have
+// been decoded.
+func (d *blockStreamDecoder) headerComplete() bool {
+	return d.block.BlockIdentifier != nil &&
+		d.block.ParentBlockIdentifier != nil &&
+		d.sawTimestamp
+}
+
+// validateHeader asserts the block without its transactions
+// is valid.
+func (d *blockStreamDecoder) validateHeader() error {
+	if err := d.f.Asserter.Block(d.block); err != nil {
+		return fmt.Errorf("/block response is invalid: %w", err)
+	}
+
+	d.validated = true
+	return nil
+}
+
+// handle validates a transaction (and that it has not
+// already been handled) and passes it to the TransactionHandler.
+func (d *blockStreamDecoder) handle(ctx context.Context, transaction *types.Transaction) error {
+	if err := d.f.Asserter.Transaction(transaction); err != nil {
+		return fmt.Errorf(
+			"transaction %s is invalid: %w",
+			types.PrintStruct(transaction.TransactionIdentifier),
+			err,
+		)
+	}
+
+	hash := transaction.TransactionIdentifier.Hash
+	if _, ok := d.seen[hash]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateTransactionHash, hash)
+	}
+	d.seen[hash] = struct{}{}
+
+	return d.handler(ctx, d.block.BlockIdentifier, transaction)
+}
+
+// StreamBlock fetches a block like Block but decodes the
+// /block response incrementally, passing each validated
+// transaction to handler instead of returning it. This
+// bounds the memory used to fetch very large blocks. Any
+// other_transactions are fetched concurrently and passed to
+// handler (in the order they were provided) after all
+// transactions included in the /block response. If the same
+// transaction hash is provided more than once, StreamBlock
+// returns ErrDuplicateTransactionHash. Transactions that precede
+// the block header are held until it is decoded, so StreamBlock
+// returns ErrBlockStreamHeaderMissing if more than 1000 do.
+//
+// The returned *types.Block does not contain any transactions.
+// If the block is omitted, StreamBlock returns nil without
+// calling handler.
+func (f *Fetcher) StreamBlock(
+	ctx context.Context,
+	network *types.NetworkIdentifier,
+	blockIdentifier *types.PartialBlockIdentifier,
+	handler TransactionHandler,
+) (*types.Block, *Error) {
+	if err := f.connectionSemaphore.Acquire(ctx, semaphoreRequestWeight); err != nil {
+		err = fmt.Errorf("failed to acquire semaphore: %w%s", err, f.metaData)
+		color.Red(err.Error())
+		return nil, &Error{
+			Err: err,
+		}
+	}
+	defer f.connectionSemaphore.Release(semaphoreRequestWeight)
+
+	body, clientErr, err := f.rosettaClient.BlockAPI.BlockStream(ctx, &types.BlockRequest{
+		NetworkIdentifier: network,
+		BlockIdentifier:   blockIdentifier,
+	})
+	if err != nil {
+		return nil, f.RequestFailedError(clientErr, err, fmt.Sprintf(
+			"/block %s",
+			types.PrintStruct(blockIdentifier),
+		))
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, body)
+		_ = body.Close()
+	}()
+
+	decoder := &blockStreamDecoder{
+		f:       f,
+		dec:     json.NewDecoder(body),
+		handler: handler,
+		seen:    map[string]struct{}{},
+	}
+	block, otherTransactions, err := decoder.decode(ctx)
+	if err != nil {
+		err = fmt.Errorf("unable to stream /block %s: %w", types.PrintStruct(blockIdentifier), err)
+		return nil, &Error{
+			Err:   err,
+			Retry: (transientError(err) || f.forceRetry) && !errors.Is(err, context.Canceled),
+		}
+	}
+
+	if block == nil || len(otherTransactions) == 0 {
+		return block, nil
+	}
+
+	// Transactions are fetched concurrently but handled in
+	// the order they were provided, so we hold any that
+	// arrive early until their predecessors are handled. At
+	// most streamWindow transactions are fetched ahead of the
+	// next transaction to handle.
+	next := 0
+	early := map[*types.TransactionIdentifier]*types.Transaction{}
+	window := make(chan struct{}, streamWindow)
+	if err := f.streamTransactions(
+		ctx,
+		network,
+		block.BlockIdentifier,
+		otherTransactions,
+		window,
+		func(fetchedTx *FetchedTransaction) error {
+			early[fetchedTx.TxIdentifier] = fetchedTx.Tx
+			for next < len(otherTransactions) {
+				transaction, ok := early[otherTransactions[next]]
+				if !ok {
+					return nil
+				}
+
+				delete(early, otherTransactions[next])
+				next++
+				<-window
+				if err := decoder.handle(ctx, transaction); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return block, nil
+}
+
+// StreamBlockRetry calls StreamBlock with a specified number
+// of retries and max elapsed time. A failed stream is only
+// retried if handler has not yet been called (otherwise
+// ErrBlockStreamInterrupted is returned and the caller must
+// discard any transactions it handled).
+func (f *Fetcher) StreamBlockRetry(
+	ctx context.Context,
+	network *types.NetworkIdentifier,
+	blockIdentifier *types.PartialBlockIdentifier,
+	handler TransactionHandler,
+) (_ *types.Block, fetchErr *Error) {
+	ctx, span := f.startSpan(ctx, "StreamBlockRetry")
+	defer func() { endSpan(span, fetchErr) }()
+
+	if err := asserter.PartialBlockIdentifier(blockIdentifier); err != nil {
+		return nil, &Error{Err: err}
+	}
+
+	backoffRetries := backoffRetries(
+		f.retryElapsedTime,
+		f.maxRetries,
+	)
+
+	for {
+		attemptCtx, attempt := f.startAttempt(ctx, "StreamBlockRetry", backoffRetries)
+		handled := false
+		block, err := f.StreamBlock(
+			attemptCtx,
+			network,
+			blockIdentifier,
+			func(
+				ctx context.Context,
+				block *types.BlockIdentifier,
+				transaction *types.Transaction,
+			) error {
+				handled = true
+				return handler(ctx, block, transaction)
+			},
+		)
+		endSpan(attempt, err)
+		if err == nil {
+			return block, nil
+		}
+
+		if ctx.Err() != nil {
+			return nil, &Error{Err: ctx.Err()}
+		}
+
+		// A retriable failure after handling transactions
+		// cannot be retried without handling them twice.
+		if handled && err.Retry {
+			return nil, &Error{
+				Err:       fmt.Errorf("%w: %w", ErrBlockStreamInterrupted, err.Err),
+				ClientErr: err.ClientErr,
+			}
+		}
+
+		if is, _ := asserter.Err(err.Err); is {
+			errForPrint := fmt.Errorf("/block not attempting retry: %w%s", err.Err, f.metaData)
+			color.Red(errForPrint.Error())
+			fetcherErr := &Error{
+				Err:       errForPrint,
+				ClientErr: err.ClientErr,
+			}
+			return nil, fetcherErr
+		}
+
+		blockFetchErr := fmt.Sprintf("block %s", types.PrintStruct(blockIdentifier))
+		color.Red("%s%s", blockFetchErr, f.metaData)
+		if err := tryAgain(blockFetchErr, backoffRetries, err); err != nil {
+			return nil, err
+		}
+	}
+}
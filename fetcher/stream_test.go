@@ -0,0 +1,328 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+func streamTransaction(hash string) *types.Transaction {
+	return &types.Transaction{
+		TransactionIdentifier: &types.TransactionIdentifier{
+			Hash: hash,
+		},
+		Operations: []*types.Operation{},
+	}
+}
+
+func TestStreamBlockRetry(t *testing.T) {
+	inBlock := []*types.Transaction{
+		streamTransaction("tx 1"),
+		streamTransaction("tx 2"),
+	}
+	other := []*types.Transaction{
+		streamTransaction("tx 3"),
+		streamTransaction("tx 4"),
+		streamTransaction("tx 5"),
+	}
+	fullBlock := &types.Block{
+		BlockIdentifier:       basicFullBlock.BlockIdentifier,
+		ParentBlockIdentifier: basicFullBlock.ParentBlockIdentifier,
+		Timestamp:             basicFullBlock.Timestamp,
+		Transactions:          inBlock,
+	}
+	otherIdentifiers := make([]*types.TransactionIdentifier, len(other))
+	for i, tx := range other {
+		otherIdentifiers[i] = tx.TransactionIdentifier
+	}
+	fullResponse := types.PrettyPrintStruct(&types.BlockResponse{
+		Block:             fullBlock,
+		OtherTransactions: otherIdentifiers,
+	})
+	manyTransactions := make([]*types.Transaction, maxPendingStreamTransactions+1)
+	for i := range manyTransactions {
+		manyTransactions[i] = streamTransaction(fmt.Sprintf("tx %d", i))
+	}
+
+	var tests = map[string]struct {
+		responses    []string
+		statusCodes  []int
+		handlerError error
+
+		expectedBlock   *types.Block
+		expectedHandled []string
+		expectedError   error
+	}{
+		"omitted block": {
+			responses: []string{`{"block":null}`},
+		},
+		"transactions and other transactions": {
+			responses:       []string{fullResponse},
+			expectedBlock:   basicFullBlock,
+			expectedHandled: []string{"tx 1", "tx 2", "tx 3", "tx 4", "tx 5"},
+		},
+		"transactions before header": {
+			responses: []string{fmt.Sprintf(
+				`{"block":{"transactions":%s,"block_identifier":%s,`+
+					`"parent_block_identifier":%s,"timestamp":%d}}`,
+				types.PrintStruct(inBlock),
+				types.PrintStruct(basicFullBlock.BlockIdentifier),
+				types.PrintStruct(basicFullBlock.ParentBlockIdentifier),
+				basicFullBlock.Timestamp,
+			)},
+			expectedBlock:   basicFullBlock,
+			expectedHandled: []string{"tx 1", "tx 2"},
+		},
+		"too many transactions before header": {
+			responses: []string{fmt.Sprintf(
+				`{"block":{"transactions":%s,"block_identifier":%s,`+
+					`"parent_block_identifier":%s,"timestamp":%d}}`,
+				types.PrintStruct(manyTransactions),
+				types.PrintStruct(basicFullBlock.BlockIdentifier),
+				types.PrintStruct(basicFullBlock.ParentBlockIdentifier),
+				basicFullBlock.Timestamp,
+			)},
+			expectedError: ErrBlockStreamHeaderMissing,
+		},
+		"retry before handling": {
+			responses: []string{
+				types.PrintStruct(&types.Error{Retriable: true}),
+				fullResponse,
+			},
+			statusCodes:     []int{http.StatusInternalServerError, http.StatusOK},
+			expectedBlock:   basicFullBlock,
+			expectedHandled: []string{"tx 1", "tx 2", "tx 3", "tx 4", "tx 5"},
+		},
+		"invalid transaction": {
+			responses: []string{fmt.Sprintf(
+				`{"block":{"block_identifier":%s,"parent_block_identifier":%s,`+
+					`"timestamp":%d,"transactions":[{"operations":[]}]}}`,
+				types.PrintStruct(basicFullBlock.BlockIdentifier),
+				types.PrintStruct(basicFullBlock.ParentBlockIdentifier),
+				basicFullBlock.Timestamp,
+			)},
+			expectedError: asserter.ErrTxIdentifierIsNil,
+		},
+		"interrupted after handling": {
+			responses: []string{
+				fullResponse[:strings.Index(fullResponse, `"tx 2"`)],
+				fullResponse,
+			},
+			expectedHandled: []string{"tx 1"},
+			expectedError:   ErrBlockStreamInterrupted,
+		},
+		"duplicate transaction": {
+			responses: []string{types.PrettyPrintStruct(&types.BlockResponse{
+				Block: &types.Block{
+					BlockIdentifier:       basicFullBlock.BlockIdentifier,
+					ParentBlockIdentifier: basicFullBlock.ParentBlockIdentifier,
+					Timestamp:             basicFullBlock.Timestamp,
+					Transactions:          []*types.Transaction{inBlock[0]},
+				},
+				OtherTransactions: []*types.TransactionIdentifier{
+					inBlock[0].TransactionIdentifier,
+				},
+			})},
+			expectedHandled: []string{"tx 1"},
+			expectedError:   ErrDuplicateTransactionHash,
+		},
+		"handler error": {
+			responses:       []string{fullResponse},
+			handlerError:    ErrNoNetworks,
+			expectedHandled: []string{"tx 1"},
+			expectedError:   ErrNoNetworks,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var (
+				assert     = assert.New(t)
+				blockTries = 0
+			)
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal("POST", r.Method)
+				w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+
+				if r.URL.RequestURI() == "/block" {
+					statusCode := http.StatusOK
+					if blockTries < len(test.statusCodes) {
+						statusCode = test.statusCodes[blockTries]
+					}
+
+					w.WriteHeader(statusCode)
+					fmt.Fprint(w, test.responses[blockTries])
+					blockTries++
+					return
+				}
+
+				assert.Equal("/block/transaction", r.URL.RequestURI())
+
+				var request types.BlockTransactionRequest
+				assert.NoError(json.NewDecoder(r.Body).Decode(&request))
+				assert.Equal(basicBlock, request.BlockIdentifier)
+
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintln(w, types.PrettyPrintStruct(&types.BlockTransactionResponse{
+					Transaction: streamTransaction(request.TransactionIdentifier.Hash),
+				}))
+			}))
+			defer ts.Close()
+
+			a, err := asserter.NewClientWithOptions(
+				basicNetwork,
+				&types.BlockIdentifier{
+					Index: 0,
+					Hash:  "block 0",
+				},
+				basicNetworkOptions.Allow.OperationTypes,
+				basicNetworkOptions.Allow.OperationStatuses,
+				nil,
+				nil,
+				&asserter.Validations{
+					Enabled: false,
+				},
+			)
+			assert.NoError(err)
+
+			f := New(
+				ts.URL,
+				WithRetryElapsedTime(5*time.Second),
+				WithMaxRetries(5),
+				WithAsserter(a),
+			)
+
+			handled := []string{}
+			block, fetchErr := f.StreamBlockRetry(
+				context.Background(),
+				basicNetwork,
+				types.ConstructPartialBlockIdentifier(basicBlock),
+				func(
+					ctx context.Context,
+					block *types.BlockIdentifier,
+					transaction *types.Transaction,
+				) error {
+					assert.Equal(basicBlock, block)
+					handled = append(handled, transaction.TransactionIdentifier.Hash)
+					return test.handlerError
+				},
+			)
+			assert.Equal(test.expectedBlock, block)
+			if len(test.expectedHandled) > 0 {
+				assert.Equal(test.expectedHandled, handled)
+			} else {
+				assert.Empty(handled)
+			}
+			assert.True(checkError(fetchErr, test.expectedError))
+		})
+	}
+}
+
+func TestStreamBlockWindow(t *testing.T) {
+	var (
+		assert    = assert.New(t)
+		requested int64
+		handled   int64
+	)
+
+	otherIdentifiers := make([]*types.TransactionIdentifier, 4*streamWindow)
+	for i := range otherIdentifiers {
+		otherIdentifiers[i] = &types.TransactionIdentifier{Hash: fmt.Sprintf("tx %d", i)}
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.WriteHeader(http.StatusOK)
+
+		if r.URL.RequestURI() == "/block" {
+			fmt.Fprint(w, types.PrintStruct(&types.BlockResponse{
+				Block: &types.Block{
+					BlockIdentifier:       basicFullBlock.BlockIdentifier,
+					ParentBlockIdentifier: basicFullBlock.ParentBlockIdentifier,
+					Timestamp:             basicFullBlock.Timestamp,
+				},
+				OtherTransactions: otherIdentifiers,
+			}))
+			return
+		}
+
+		var request types.BlockTransactionRequest
+		assert.NoError(json.NewDecoder(r.Body).Decode(&request))
+
+		// No more than streamWindow transactions are
+		// fetched ahead of the next one to handle.
+		outstanding := atomic.AddInt64(&requested, 1) - atomic.LoadInt64(&handled)
+		assert.LessOrEqual(outstanding, int64(streamWindow))
+
+		// Delay the first transaction so that the
+		// others arrive before it.
+		if request.TransactionIdentifier.Hash == "tx 0" {
+			time.Sleep(100 * time.Millisecond)
+		}
+
+		fmt.Fprint(w, types.PrintStruct(&types.BlockTransactionResponse{
+			Transaction: streamTransaction(request.TransactionIdentifier.Hash),
+		}))
+	}))
+	defer ts.Close()
+
+	a, err := asserter.NewClientWithOptions(
+		basicNetwork,
+		&types.BlockIdentifier{
+			Index: 0,
+			Hash:  "block 0",
+		},
+		basicNetworkOptions.Allow.OperationTypes,
+		basicNetworkOptions.Allow.OperationStatuses,
+		nil,
+		nil,
+		&asserter.Validations{
+			Enabled: false,
+		},
+	)
+	assert.NoError(err)
+
+	f := New(ts.URL, WithAsserter(a))
+
+	_, fetchErr := f.StreamBlock(
+		context.Background(),
+		basicNetwork,
+		types.ConstructPartialBlockIdentifier(basicBlock),
+		func(
+			ctx context.Context,
+			block *types.BlockIdentifier,
+			transaction *types.Transaction,
+		) error {
+			atomic.AddInt64(&handled, 1)
+			return nil
+		},
+	)
+	assert.Nil(fetchErr)
+	assert.Equal(int64(len(otherIdentifiers)), atomic.LoadInt64(&handled))
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	// tracerName is the name of the trace.Tracer used
+	// to create spans in the fetcher.
+	tracerName = "github.com/dominant-strategies/mesh-sdk-go/fetcher"
+
+	// retryAttemptKey is the attribute that records
+	// the attempt number of a retry span.
+	retryAttemptKey = attribute.Key("mesh.retry.attempt")
+)
+
+// tracer returns the trace.Tracer used by the fetcher
+// (a no-op trace.Tracer if no TracerProvider was provided).
+func (f *Fetcher) tracer() trace.Tracer {
+	if f.tracerProvider == nil {
+		return noop.NewTracerProvider().Tracer(tracerName)
+	}
+
+	return f.tracerProvider.Tracer(tracerName)
+}
+
+// startSpan starts a span for a *Retry method.
+func (f *Fetcher) startSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return f.tracer().Start(ctx, name)
+}
+
+// startAttempt starts a child span for a single
+// attempt of a *Retry method.
+func (f *Fetcher) startAttempt(
+	ctx context.Context,
+	name string,
+	backoff *Backoff,
+) (context.Context, trace.Span) {
+	return f.tracer().Start(
+		ctx,
+		name+" attempt",
+		trace.WithAttributes(retryAttemptKey.Int(backoff.attempts+1)),
+	)
+}
+
+// endSpan records err (if populated) on span
+// and ends it.
+func endSpan(span trace.Span, err *Error) {
+	if err != nil && err.Err != nil {
+		span.RecordError(err.Err)
+		span.SetStatus(codes.Error, err.Err.Error())
+		span.SetAttributes(attribute.Bool("mesh.retriable", err.Retry))
+		if err.ClientErr != nil {
+			span.SetAttributes(attribute.Int64("mesh.error.code", int64(err.ClientErr.Code)))
+		}
+	}
+
+	span.End()
+}
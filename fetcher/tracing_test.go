@@ -0,0 +1,162 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fetcher
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	"github.com/dominant-strategies/mesh-sdk-go/server"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// tracingNetworkServicer fails the first errorsBeforeSuccess
+// /network/status requests with a retriable error.
+type tracingNetworkServicer struct {
+	errorsBeforeSuccess int
+	calls               int
+}
+
+func (s *tracingNetworkServicer) NetworkList(
+	context.Context,
+	*types.MetadataRequest,
+) (*types.NetworkListResponse, *types.Error) {
+	return basicNetworkList, nil
+}
+
+func (s *tracingNetworkServicer) NetworkOptions(
+	context.Context,
+	*types.NetworkRequest,
+) (*types.NetworkOptionsResponse, *types.Error) {
+	return basicNetworkOptions, nil
+}
+
+func (s *tracingNetworkServicer) NetworkStatus(
+	context.Context,
+	*types.NetworkRequest,
+) (*types.NetworkStatusResponse, *types.Error) {
+	s.calls++
+	if s.calls <= s.errorsBeforeSuccess {
+		return nil, &types.Error{Code: 1, Message: "node not ready", Retriable: true}
+	}
+
+	return basicNetworkStatus, nil
+}
+
+func TestTracing(t *testing.T) {
+	assert := assert.New(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	serverAsserter, err := asserter.NewServer(
+		[]string{"transfer"},
+		false,
+		[]*types.NetworkIdentifier{basicNetwork},
+		nil,
+		false,
+		"",
+	)
+	assert.NoError(err)
+
+	router := server.NewRouter(
+		server.NewNetworkAPIController(
+			&tracingNetworkServicer{errorsBeforeSuccess: 1},
+			serverAsserter,
+		),
+	)
+	ts := httptest.NewServer(server.TracingMiddleware(tracerProvider, router))
+	defer ts.Close()
+
+	a, err := asserter.NewClientWithOptions(
+		basicNetwork,
+		&types.BlockIdentifier{
+			Index: 0,
+			Hash:  "block 0",
+		},
+		basicNetworkOptions.Allow.OperationTypes,
+		basicNetworkOptions.Allow.OperationStatuses,
+		nil,
+		nil,
+		&asserter.Validations{
+			Enabled: false,
+		},
+	)
+	assert.NoError(err)
+
+	f := New(
+		ts.URL,
+		WithRetryElapsedTime(5*time.Second),
+		WithMaxRetries(5),
+		WithAsserter(a),
+		WithTracerProvider(tracerProvider),
+	)
+
+	status, fetchErr := f.NetworkStatusRetry(context.Background(), basicNetwork, nil)
+	assert.Nil(fetchErr)
+	assert.Equal(basicNetworkStatus, status)
+
+	spansByName := map[string][]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spansByName[span.Name()] = append(spansByName[span.Name()], span)
+	}
+
+	// NetworkStatusRetry -> attempt -> /network/status -> NetworkStatus
+	retrySpans := spansByName["NetworkStatusRetry"]
+	attemptSpans := spansByName["NetworkStatusRetry attempt"]
+	clientSpans := spansByName["/network/status"]
+	serverSpans := spansByName["NetworkStatus"]
+	assert.Len(retrySpans, 1)
+	assert.Len(attemptSpans, 2)
+	assert.Len(clientSpans, 2)
+	assert.Len(serverSpans, 2)
+
+	traceID := retrySpans[0].SpanContext().TraceID()
+	assert.Equal(codes.Unset, retrySpans[0].Status().Code)
+	for i := 0; i < 2; i++ {
+		attempt := attemptSpans[i]
+		assert.Equal(retrySpans[0].SpanContext().SpanID(), attempt.Parent().SpanID())
+		assert.Contains(attempt.Attributes(), retryAttemptKey.Int(i+1))
+
+		client := clientSpans[i]
+		assert.Equal(attempt.SpanContext().SpanID(), client.Parent().SpanID())
+
+		// The server span is propagated from the client span
+		// using W3C trace context headers.
+		server := serverSpans[i]
+		assert.True(server.Parent().IsRemote())
+		assert.Equal(client.SpanContext().SpanID(), server.Parent().SpanID())
+
+		for _, span := range []sdktrace.ReadOnlySpan{attempt, client, server} {
+			assert.Equal(traceID, span.SpanContext().TraceID())
+		}
+	}
+
+	// Only the first attempt failed.
+	assert.Equal(codes.Error, attemptSpans[0].Status().Code)
+	assert.Equal(codes.Error, clientSpans[0].Status().Code)
+	assert.Equal(codes.Error, serverSpans[0].Status().Code)
+	assert.Equal(codes.Unset, attemptSpans[1].Status().Code)
+	assert.Equal(codes.Unset, clientSpans[1].Status().Code)
+	assert.Equal(codes.Unset, serverSpans[1].Status().Code)
+}
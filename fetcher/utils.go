@@ -90,10 +90,12 @@ func tryAgain(fetchMsg string, thisBackoff *Backoff, err *Error) *Error {
 	if nextBackoff == backoff.Stop {
 		return &Error{
 			Err: fmt.Errorf(
-				"fetch message %s: %w",
+				"fetch message %s: %w: %w",
 				fetchMsg,
 				ErrExhaustedRetries,
+				err.Err,
 			),
+			ClientErr: err.ClientErr,
 		}
 	}
 
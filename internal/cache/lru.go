@@ -0,0 +1,206 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache implements the LRU cache of responses
+// shared by the fetcher and server packages.
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultSize is the default number of
+// values held in memory by an *LRU.
+const DefaultSize = 1000
+
+// Backend persists values cached by an *LRU so that they
+// survive restarts (and can be shared by multiple processes).
+// A database.Database-backed implementation is provided by
+// storage/modules.
+type Backend interface {
+	Get(ctx context.Context, key string) (bool, []byte, error)
+	Set(ctx context.Context, key string, value []byte) error
+}
+
+// Stats are the counters of an *LRU.
+type Stats struct {
+	// Hits is the number of lookups served from
+	// memory or the Backend.
+	Hits uint64 `json:"hits"`
+
+	// BackendHits is the number of Hits served
+	// from the Backend.
+	BackendHits uint64 `json:"backend_hits"`
+
+	Misses    uint64 `json:"misses"`
+	Evictions uint64 `json:"evictions"`
+
+	// Expirations is the number of values
+	// dropped because they expired.
+	Expirations uint64 `json:"expirations"`
+
+	// BackendErrors is the number of failed Backend
+	// lookups and writes. These errors are not returned
+	// to callers.
+	BackendErrors uint64 `json:"backend_errors"`
+
+	// Size is the number of values held in memory.
+	Size int `json:"size"`
+}
+
+type entry struct {
+	key   string
+	value []byte
+
+	// expiration is zero for values
+	// that do not expire.
+	expiration time.Time
+}
+
+// LRU is a least recently used cache of values (optionally
+// persisted to a Backend). Values that do not expire are
+// written to the Backend. Values that expire are only held
+// in memory.
+type LRU struct {
+	size    int
+	backend Backend
+
+	mutex   sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+	stats   Stats
+}
+
+// NewLRU constructs a new *LRU that holds up to size values
+// in memory. If size is <= 0, DefaultSize is used. The backend
+// is optional.
+func NewLRU(size int, backend Backend) *LRU {
+	if size <= 0 {
+		size = DefaultSize
+	}
+
+	return &LRU{
+		size:    size,
+		backend: backend,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+	}
+}
+
+// Stats returns a copy of the current *Stats.
+func (c *LRU) Stats() *Stats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	stats := c.stats
+	stats.Size = c.order.Len()
+	return &stats
+}
+
+// add inserts a value into memory, evicting the
+// least recently used value if the cache is full.
+// This must be called while holding the mutex.
+func (c *LRU) add(key string, value []byte, expiration time.Time) {
+	if element, ok := c.entries[key]; ok {
+		e := element.Value.(*entry)
+		e.value = value
+		e.expiration = expiration
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&entry{
+		key:        key,
+		value:      value,
+		expiration: expiration,
+	})
+	if c.order.Len() <= c.size {
+		return
+	}
+
+	oldest := c.order.Back()
+	c.order.Remove(oldest)
+	delete(c.entries, oldest.Value.(*entry).key)
+	c.stats.Evictions++
+}
+
+// Get returns the value stored for key and a boolean
+// indicating if it was found. If the value is not in
+// memory and persisted is true, it is looked up in the
+// Backend (if provided).
+func (c *LRU) Get(ctx context.Context, key string, persisted bool) ([]byte, bool) {
+	c.mutex.Lock()
+	if element, ok := c.entries[key]; ok {
+		e := element.Value.(*entry)
+		if e.expiration.IsZero() || time.Now().Before(e.expiration) {
+			c.order.MoveToFront(element)
+			c.stats.Hits++
+			c.mutex.Unlock()
+
+			return e.value, true
+		}
+
+		c.order.Remove(element)
+		delete(c.entries, key)
+		c.stats.Expirations++
+	}
+	c.mutex.Unlock()
+
+	if persisted && c.backend != nil {
+		exists, value, err := c.backend.Get(ctx, key)
+		if err == nil && exists {
+			c.mutex.Lock()
+			c.add(key, value, time.Time{})
+			c.stats.Hits++
+			c.stats.BackendHits++
+			c.mutex.Unlock()
+
+			return value, true
+		}
+
+		if err != nil {
+			c.mutex.Lock()
+			c.stats.BackendErrors++
+			c.mutex.Unlock()
+		}
+	}
+
+	c.mutex.Lock()
+	c.stats.Misses++
+	c.mutex.Unlock()
+
+	return nil, false
+}
+
+// Set stores value for key in memory until expiration. If
+// expiration is zero, the value does not expire and is
+// also stored in the Backend (if provided).
+func (c *LRU) Set(ctx context.Context, key string, value []byte, expiration time.Time) {
+	c.mutex.Lock()
+	c.add(key, value, expiration)
+	c.mutex.Unlock()
+
+	if !expiration.IsZero() || c.backend == nil {
+		return
+	}
+
+	if err := c.backend.Set(ctx, key, value); err != nil {
+		c.mutex.Lock()
+		c.stats.BackendErrors++
+		c.mutex.Unlock()
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mapBackend struct {
+	values map[string][]byte
+	err    error
+}
+
+func (m *mapBackend) Get(ctx context.Context, key string) (bool, []byte, error) {
+	if m.err != nil {
+		return false, nil, m.err
+	}
+
+	value, ok := m.values[key]
+	return ok, value, nil
+}
+
+func (m *mapBackend) Set(ctx context.Context, key string, value []byte) error {
+	if m.err != nil {
+		return m.err
+	}
+
+	m.values[key] = value
+	return nil
+}
+
+func TestLRU(t *testing.T) {
+	var (
+		assert  = assert.New(t)
+		ctx     = context.Background()
+		backend = &mapBackend{values: map[string][]byte{}}
+		c       = NewLRU(2, backend)
+	)
+
+	c.Set(ctx, "a", []byte("1"), time.Time{})
+	c.Set(ctx, "b", []byte("2"), time.Time{})
+	value, ok := c.Get(ctx, "a", true)
+	assert.True(ok)
+	assert.Equal([]byte("1"), value)
+
+	// "b" is the least recently used key, but
+	// it can still be found in the Backend.
+	c.Set(ctx, "c", []byte("3"), time.Time{})
+	_, ok = c.Get(ctx, "b", false)
+	assert.False(ok)
+	value, ok = c.Get(ctx, "b", true)
+	assert.True(ok)
+	assert.Equal([]byte("2"), value)
+
+	// Values that expire are not persisted.
+	c.Set(ctx, "d", []byte("4"), time.Now().Add(-time.Second))
+	assert.NotContains(backend.values, "d")
+	_, ok = c.Get(ctx, "d", true)
+	assert.False(ok)
+
+	assert.Equal(&Stats{
+		Hits:        2,
+		BackendHits: 1,
+		Misses:      2,
+		Evictions:   3,
+		Expirations: 1,
+		Size:        1,
+	}, c.Stats())
+}
+
+func TestLRUBackendErrors(t *testing.T) {
+	var (
+		assert = assert.New(t)
+		ctx    = context.Background()
+		c      = NewLRU(0, &mapBackend{err: errors.New("unavailable")})
+	)
+
+	c.Set(ctx, "a", []byte("1"), time.Time{})
+	value, ok := c.Get(ctx, "a", true)
+	assert.True(ok)
+	assert.Equal([]byte("1"), value)
+
+	_, ok = c.Get(ctx, "b", true)
+	assert.False(ok)
+
+	assert.Equal(&Stats{
+		Hits:          1,
+		Misses:        1,
+		BackendErrors: 2,
+		Size:          1,
+	}, c.Stats())
+}
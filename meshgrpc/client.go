@@ -0,0 +1,802 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by meshgrpc/gen. DO NOT EDIT.
+
+package meshgrpc
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// Client invokes the Mesh API over a gRPC connection. Its
+// methods mirror those of client.APIClient.
+type Client struct {
+	accountAPI      AccountAPIClient
+	blockAPI        BlockAPIClient
+	callAPI         CallAPIClient
+	constructionAPI ConstructionAPIClient
+	eventsAPI       EventsAPIClient
+	mempoolAPI      MempoolAPIClient
+	networkAPI      NetworkAPIClient
+	searchAPI       SearchAPIClient
+}
+
+// NewClient returns a new *Client using a gRPC connection.
+func NewClient(conn grpc.ClientConnInterface) *Client {
+	return &Client{
+		accountAPI:      NewAccountAPIClient(conn),
+		blockAPI:        NewBlockAPIClient(conn),
+		callAPI:         NewCallAPIClient(conn),
+		constructionAPI: NewConstructionAPIClient(conn),
+		eventsAPI:       NewEventsAPIClient(conn),
+		mempoolAPI:      NewMempoolAPIClient(conn),
+		networkAPI:      NewNetworkAPIClient(conn),
+		searchAPI:       NewSearchAPIClient(conn),
+	}
+}
+
+// AccountBalance invokes /account/balance.
+func (c *Client) AccountBalance(
+	ctx context.Context,
+	request *types.AccountBalanceRequest,
+) (*types.AccountBalanceResponse, *types.Error, error) {
+	req, err := accountBalanceRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.accountAPI.AccountBalance(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := accountBalanceResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// AccountCoins invokes /account/coins.
+func (c *Client) AccountCoins(
+	ctx context.Context,
+	request *types.AccountCoinsRequest,
+) (*types.AccountCoinsResponse, *types.Error, error) {
+	req, err := accountCoinsRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.accountAPI.AccountCoins(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := accountCoinsResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// Block invokes /block.
+func (c *Client) Block(
+	ctx context.Context,
+	request *types.BlockRequest,
+) (*types.BlockResponse, *types.Error, error) {
+	req, err := blockRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.blockAPI.Block(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := blockResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// BlockTransaction invokes /block/transaction.
+func (c *Client) BlockTransaction(
+	ctx context.Context,
+	request *types.BlockTransactionRequest,
+) (*types.BlockTransactionResponse, *types.Error, error) {
+	req, err := blockTransactionRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.blockAPI.BlockTransaction(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := blockTransactionResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// Call invokes /call.
+func (c *Client) Call(
+	ctx context.Context,
+	request *types.CallRequest,
+) (*types.CallResponse, *types.Error, error) {
+	req, err := callRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.callAPI.Call(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := callResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// ConstructionCombine invokes /construction/combine.
+func (c *Client) ConstructionCombine(
+	ctx context.Context,
+	request *types.ConstructionCombineRequest,
+) (*types.ConstructionCombineResponse, *types.Error, error) {
+	req, err := constructionCombineRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.constructionAPI.ConstructionCombine(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := constructionCombineResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// ConstructionDerive invokes /construction/derive.
+func (c *Client) ConstructionDerive(
+	ctx context.Context,
+	request *types.ConstructionDeriveRequest,
+) (*types.ConstructionDeriveResponse, *types.Error, error) {
+	req, err := constructionDeriveRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.constructionAPI.ConstructionDerive(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := constructionDeriveResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// ConstructionHash invokes /construction/hash.
+func (c *Client) ConstructionHash(
+	ctx context.Context,
+	request *types.ConstructionHashRequest,
+) (*types.TransactionIdentifierResponse, *types.Error, error) {
+	req, err := constructionHashRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.constructionAPI.ConstructionHash(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := transactionIdentifierResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// ConstructionMetadata invokes /construction/metadata.
+func (c *Client) ConstructionMetadata(
+	ctx context.Context,
+	request *types.ConstructionMetadataRequest,
+) (*types.ConstructionMetadataResponse, *types.Error, error) {
+	req, err := constructionMetadataRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.constructionAPI.ConstructionMetadata(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := constructionMetadataResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// ConstructionParse invokes /construction/parse.
+func (c *Client) ConstructionParse(
+	ctx context.Context,
+	request *types.ConstructionParseRequest,
+) (*types.ConstructionParseResponse, *types.Error, error) {
+	req, err := constructionParseRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.constructionAPI.ConstructionParse(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := constructionParseResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// ConstructionPayloads invokes /construction/payloads.
+func (c *Client) ConstructionPayloads(
+	ctx context.Context,
+	request *types.ConstructionPayloadsRequest,
+) (*types.ConstructionPayloadsResponse, *types.Error, error) {
+	req, err := constructionPayloadsRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.constructionAPI.ConstructionPayloads(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := constructionPayloadsResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// ConstructionPreprocess invokes /construction/preprocess.
+func (c *Client) ConstructionPreprocess(
+	ctx context.Context,
+	request *types.ConstructionPreprocessRequest,
+) (*types.ConstructionPreprocessResponse, *types.Error, error) {
+	req, err := constructionPreprocessRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.constructionAPI.ConstructionPreprocess(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := constructionPreprocessResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// ConstructionSubmit invokes /construction/submit.
+func (c *Client) ConstructionSubmit(
+	ctx context.Context,
+	request *types.ConstructionSubmitRequest,
+) (*types.TransactionIdentifierResponse, *types.Error, error) {
+	req, err := constructionSubmitRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.constructionAPI.ConstructionSubmit(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := transactionIdentifierResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// EventsBlocks invokes /events/blocks.
+func (c *Client) EventsBlocks(
+	ctx context.Context,
+	request *types.EventsBlocksRequest,
+) (*types.EventsBlocksResponse, *types.Error, error) {
+	req, err := eventsBlocksRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.eventsAPI.EventsBlocks(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := eventsBlocksResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// Mempool invokes /mempool.
+func (c *Client) Mempool(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.MempoolResponse, *types.Error, error) {
+	req, err := networkRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.mempoolAPI.Mempool(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := mempoolResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// MempoolTransaction invokes /mempool/transaction.
+func (c *Client) MempoolTransaction(
+	ctx context.Context,
+	request *types.MempoolTransactionRequest,
+) (*types.MempoolTransactionResponse, *types.Error, error) {
+	req, err := mempoolTransactionRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.mempoolAPI.MempoolTransaction(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := mempoolTransactionResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// NetworkList invokes /network/list.
+func (c *Client) NetworkList(
+	ctx context.Context,
+	request *types.MetadataRequest,
+) (*types.NetworkListResponse, *types.Error, error) {
+	req, err := metadataRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.networkAPI.NetworkList(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := networkListResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// NetworkOptions invokes /network/options.
+func (c *Client) NetworkOptions(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.NetworkOptionsResponse, *types.Error, error) {
+	req, err := networkRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.networkAPI.NetworkOptions(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := networkOptionsResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// NetworkStatus invokes /network/status.
+func (c *Client) NetworkStatus(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.NetworkStatusResponse, *types.Error, error) {
+	req, err := networkRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.networkAPI.NetworkStatus(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := networkStatusResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// SearchTransactions invokes /search/transactions.
+func (c *Client) SearchTransactions(
+	ctx context.Context,
+	request *types.SearchTransactionsRequest,
+) (*types.SearchTransactionsResponse, *types.Error, error) {
+	req, err := searchTransactionsRequestToProto(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.searchAPI.SearchTransactions(ctx, req)
+	if err != nil {
+		clientErr, err := statusError(err)
+		return nil, clientErr, err
+	}
+
+	response, err := searchTransactionsResponseFromProto(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, nil, nil
+}
+
+// routes invokes the *Client method for each
+// Mesh path with a JSON request body.
+var routes = map[string]func(
+	ctx context.Context,
+	c *Client,
+	body []byte,
+) (interface{}, *types.Error, error){
+	"/account/balance": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.AccountBalanceRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.AccountBalance(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/account/coins": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.AccountCoinsRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.AccountCoins(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/block": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.BlockRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.Block(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/block/transaction": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.BlockTransactionRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.BlockTransaction(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/call": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.CallRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.Call(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/construction/combine": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.ConstructionCombineRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.ConstructionCombine(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/construction/derive": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.ConstructionDeriveRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.ConstructionDerive(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/construction/hash": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.ConstructionHashRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.ConstructionHash(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/construction/metadata": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.ConstructionMetadataRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.ConstructionMetadata(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/construction/parse": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.ConstructionParseRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.ConstructionParse(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/construction/payloads": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.ConstructionPayloadsRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.ConstructionPayloads(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/construction/preprocess": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.ConstructionPreprocessRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.ConstructionPreprocess(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/construction/submit": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.ConstructionSubmitRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.ConstructionSubmit(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/events/blocks": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.EventsBlocksRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.EventsBlocks(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/mempool": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.NetworkRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.Mempool(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/mempool/transaction": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.MempoolTransactionRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.MempoolTransaction(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/network/list": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.MetadataRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.NetworkList(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/network/options": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.NetworkRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.NetworkOptions(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/network/status": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.NetworkRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.NetworkStatus(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+	"/search/transactions": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {
+		request := &types.SearchTransactionsRequest{}
+		if err := json.Unmarshal(body, request); err != nil {
+			return nil, nil, err
+		}
+
+		response, clientErr, err := c.SearchTransactions(ctx, request)
+		if err != nil {
+			return nil, clientErr, err
+		}
+
+		return response, nil, nil
+	},
+}
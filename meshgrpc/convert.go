@@ -0,0 +1,2753 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by meshgrpc/gen. DO NOT EDIT.
+
+package meshgrpc
+
+import (
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// accountBalanceRequestToProto converts a *types.AccountBalanceRequest to a *AccountBalanceRequest.
+func accountBalanceRequestToProto(v *types.AccountBalanceRequest) (*AccountBalanceRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &AccountBalanceRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.AccountIdentifier, err = accountIdentifierToProto(v.AccountIdentifier); err != nil {
+		return nil, err
+	}
+	if out.BlockIdentifier, err = partialBlockIdentifierToProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+	if len(v.Currencies) > 0 {
+		out.Currencies = make([]*Currency, len(v.Currencies))
+		for i, item := range v.Currencies {
+			if out.Currencies[i], err = currencyToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// accountBalanceRequestFromProto converts a *AccountBalanceRequest to a *types.AccountBalanceRequest.
+func accountBalanceRequestFromProto(v *AccountBalanceRequest) (*types.AccountBalanceRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.AccountBalanceRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.AccountIdentifier, err = accountIdentifierFromProto(v.AccountIdentifier); err != nil {
+		return nil, err
+	}
+	if out.BlockIdentifier, err = partialBlockIdentifierFromProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+	if len(v.Currencies) > 0 {
+		out.Currencies = make([]*types.Currency, len(v.Currencies))
+		for i, item := range v.Currencies {
+			if out.Currencies[i], err = currencyFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// accountBalanceResponseToProto converts a *types.AccountBalanceResponse to a *AccountBalanceResponse.
+func accountBalanceResponseToProto(v *types.AccountBalanceResponse) (*AccountBalanceResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &AccountBalanceResponse{}
+	if out.BlockIdentifier, err = blockIdentifierToProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+	if len(v.Balances) > 0 {
+		out.Balances = make([]*Amount, len(v.Balances))
+		for i, item := range v.Balances {
+			if out.Balances[i], err = amountToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// accountBalanceResponseFromProto converts a *AccountBalanceResponse to a *types.AccountBalanceResponse.
+func accountBalanceResponseFromProto(v *AccountBalanceResponse) (*types.AccountBalanceResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.AccountBalanceResponse{}
+	if out.BlockIdentifier, err = blockIdentifierFromProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+	if len(v.Balances) > 0 {
+		out.Balances = make([]*types.Amount, len(v.Balances))
+		for i, item := range v.Balances {
+			if out.Balances[i], err = amountFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// accountCoinsRequestToProto converts a *types.AccountCoinsRequest to a *AccountCoinsRequest.
+func accountCoinsRequestToProto(v *types.AccountCoinsRequest) (*AccountCoinsRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &AccountCoinsRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.AccountIdentifier, err = accountIdentifierToProto(v.AccountIdentifier); err != nil {
+		return nil, err
+	}
+	out.IncludeMempool = v.IncludeMempool
+	if len(v.Currencies) > 0 {
+		out.Currencies = make([]*Currency, len(v.Currencies))
+		for i, item := range v.Currencies {
+			if out.Currencies[i], err = currencyToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// accountCoinsRequestFromProto converts a *AccountCoinsRequest to a *types.AccountCoinsRequest.
+func accountCoinsRequestFromProto(v *AccountCoinsRequest) (*types.AccountCoinsRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.AccountCoinsRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.AccountIdentifier, err = accountIdentifierFromProto(v.AccountIdentifier); err != nil {
+		return nil, err
+	}
+	out.IncludeMempool = v.IncludeMempool
+	if len(v.Currencies) > 0 {
+		out.Currencies = make([]*types.Currency, len(v.Currencies))
+		for i, item := range v.Currencies {
+			if out.Currencies[i], err = currencyFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// accountCoinsResponseToProto converts a *types.AccountCoinsResponse to a *AccountCoinsResponse.
+func accountCoinsResponseToProto(v *types.AccountCoinsResponse) (*AccountCoinsResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &AccountCoinsResponse{}
+	if out.BlockIdentifier, err = blockIdentifierToProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+	if len(v.Coins) > 0 {
+		out.Coins = make([]*Coin, len(v.Coins))
+		for i, item := range v.Coins {
+			if out.Coins[i], err = coinToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// accountCoinsResponseFromProto converts a *AccountCoinsResponse to a *types.AccountCoinsResponse.
+func accountCoinsResponseFromProto(v *AccountCoinsResponse) (*types.AccountCoinsResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.AccountCoinsResponse{}
+	if out.BlockIdentifier, err = blockIdentifierFromProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+	if len(v.Coins) > 0 {
+		out.Coins = make([]*types.Coin, len(v.Coins))
+		for i, item := range v.Coins {
+			if out.Coins[i], err = coinFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// accountIdentifierToProto converts a *types.AccountIdentifier to a *AccountIdentifier.
+func accountIdentifierToProto(v *types.AccountIdentifier) (*AccountIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &AccountIdentifier{}
+	out.Address = v.Address
+	if out.SubAccount, err = subAccountIdentifierToProto(v.SubAccount); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// accountIdentifierFromProto converts a *AccountIdentifier to a *types.AccountIdentifier.
+func accountIdentifierFromProto(v *AccountIdentifier) (*types.AccountIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.AccountIdentifier{}
+	out.Address = v.Address
+	if out.SubAccount, err = subAccountIdentifierFromProto(v.SubAccount); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// allowToProto converts a *types.Allow to a *Allow.
+func allowToProto(v *types.Allow) (*Allow, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &Allow{}
+	if len(v.OperationStatuses) > 0 {
+		out.OperationStatuses = make([]*OperationStatus, len(v.OperationStatuses))
+		for i, item := range v.OperationStatuses {
+			if out.OperationStatuses[i], err = operationStatusToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	out.OperationTypes = v.OperationTypes
+	if len(v.Errors) > 0 {
+		out.Errors = make([]*Error, len(v.Errors))
+		for i, item := range v.Errors {
+			if out.Errors[i], err = errorToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	out.HistoricalBalanceLookup = v.HistoricalBalanceLookup
+	out.TimestampStartIndex = v.TimestampStartIndex
+	out.CallMethods = v.CallMethods
+	if len(v.BalanceExemptions) > 0 {
+		out.BalanceExemptions = make([]*BalanceExemption, len(v.BalanceExemptions))
+		for i, item := range v.BalanceExemptions {
+			if out.BalanceExemptions[i], err = balanceExemptionToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	out.MempoolCoins = v.MempoolCoins
+	out.BlockHashCase = string(v.BlockHashCase)
+	out.TransactionHashCase = string(v.TransactionHashCase)
+
+	return out, err
+}
+
+// allowFromProto converts a *Allow to a *types.Allow.
+func allowFromProto(v *Allow) (*types.Allow, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.Allow{}
+	if len(v.OperationStatuses) > 0 {
+		out.OperationStatuses = make([]*types.OperationStatus, len(v.OperationStatuses))
+		for i, item := range v.OperationStatuses {
+			if out.OperationStatuses[i], err = operationStatusFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	out.OperationTypes = v.OperationTypes
+	if len(v.Errors) > 0 {
+		out.Errors = make([]*types.Error, len(v.Errors))
+		for i, item := range v.Errors {
+			if out.Errors[i], err = errorFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	out.HistoricalBalanceLookup = v.HistoricalBalanceLookup
+	out.TimestampStartIndex = v.TimestampStartIndex
+	out.CallMethods = v.CallMethods
+	if len(v.BalanceExemptions) > 0 {
+		out.BalanceExemptions = make([]*types.BalanceExemption, len(v.BalanceExemptions))
+		for i, item := range v.BalanceExemptions {
+			if out.BalanceExemptions[i], err = balanceExemptionFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	out.MempoolCoins = v.MempoolCoins
+	out.BlockHashCase = types.Case(v.BlockHashCase)
+	out.TransactionHashCase = types.Case(v.TransactionHashCase)
+
+	return out, err
+}
+
+// amountToProto converts a *types.Amount to a *Amount.
+func amountToProto(v *types.Amount) (*Amount, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &Amount{}
+	out.Value = v.Value
+	if out.Currency, err = currencyToProto(v.Currency); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// amountFromProto converts a *Amount to a *types.Amount.
+func amountFromProto(v *Amount) (*types.Amount, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.Amount{}
+	out.Value = v.Value
+	if out.Currency, err = currencyFromProto(v.Currency); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// balanceExemptionToProto converts a *types.BalanceExemption to a *BalanceExemption.
+func balanceExemptionToProto(v *types.BalanceExemption) (*BalanceExemption, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &BalanceExemption{}
+	out.SubAccountAddress = v.SubAccountAddress
+	if out.Currency, err = currencyToProto(v.Currency); err != nil {
+		return nil, err
+	}
+	out.ExemptionType = string(v.ExemptionType)
+
+	return out, err
+}
+
+// balanceExemptionFromProto converts a *BalanceExemption to a *types.BalanceExemption.
+func balanceExemptionFromProto(v *BalanceExemption) (*types.BalanceExemption, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.BalanceExemption{}
+	out.SubAccountAddress = v.SubAccountAddress
+	if out.Currency, err = currencyFromProto(v.Currency); err != nil {
+		return nil, err
+	}
+	out.ExemptionType = types.ExemptionType(v.ExemptionType)
+
+	return out, err
+}
+
+// blockToProto converts a *types.Block to a *Block.
+func blockToProto(v *types.Block) (*Block, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &Block{}
+	if out.BlockIdentifier, err = blockIdentifierToProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+	if out.ParentBlockIdentifier, err = blockIdentifierToProto(v.ParentBlockIdentifier); err != nil {
+		return nil, err
+	}
+	out.Timestamp = v.Timestamp
+	if len(v.Transactions) > 0 {
+		out.Transactions = make([]*Transaction, len(v.Transactions))
+		for i, item := range v.Transactions {
+			if out.Transactions[i], err = transactionToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// blockFromProto converts a *Block to a *types.Block.
+func blockFromProto(v *Block) (*types.Block, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.Block{}
+	if out.BlockIdentifier, err = blockIdentifierFromProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+	if out.ParentBlockIdentifier, err = blockIdentifierFromProto(v.ParentBlockIdentifier); err != nil {
+		return nil, err
+	}
+	out.Timestamp = v.Timestamp
+	if len(v.Transactions) > 0 {
+		out.Transactions = make([]*types.Transaction, len(v.Transactions))
+		for i, item := range v.Transactions {
+			if out.Transactions[i], err = transactionFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// blockEventToProto converts a *types.BlockEvent to a *BlockEvent.
+func blockEventToProto(v *types.BlockEvent) (*BlockEvent, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &BlockEvent{}
+	out.Sequence = v.Sequence
+	if out.BlockIdentifier, err = blockIdentifierToProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+	out.Type = string(v.Type)
+
+	return out, err
+}
+
+// blockEventFromProto converts a *BlockEvent to a *types.BlockEvent.
+func blockEventFromProto(v *BlockEvent) (*types.BlockEvent, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.BlockEvent{}
+	out.Sequence = v.Sequence
+	if out.BlockIdentifier, err = blockIdentifierFromProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+	out.Type = types.BlockEventType(v.Type)
+
+	return out, err
+}
+
+// blockIdentifierToProto converts a *types.BlockIdentifier to a *BlockIdentifier.
+func blockIdentifierToProto(v *types.BlockIdentifier) (*BlockIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &BlockIdentifier{}
+	out.Index = v.Index
+	out.Hash = v.Hash
+
+	return out, err
+}
+
+// blockIdentifierFromProto converts a *BlockIdentifier to a *types.BlockIdentifier.
+func blockIdentifierFromProto(v *BlockIdentifier) (*types.BlockIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.BlockIdentifier{}
+	out.Index = v.Index
+	out.Hash = v.Hash
+
+	return out, err
+}
+
+// blockRequestToProto converts a *types.BlockRequest to a *BlockRequest.
+func blockRequestToProto(v *types.BlockRequest) (*BlockRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &BlockRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.BlockIdentifier, err = partialBlockIdentifierToProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// blockRequestFromProto converts a *BlockRequest to a *types.BlockRequest.
+func blockRequestFromProto(v *BlockRequest) (*types.BlockRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.BlockRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.BlockIdentifier, err = partialBlockIdentifierFromProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// blockResponseToProto converts a *types.BlockResponse to a *BlockResponse.
+func blockResponseToProto(v *types.BlockResponse) (*BlockResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &BlockResponse{}
+	if out.Block, err = blockToProto(v.Block); err != nil {
+		return nil, err
+	}
+	if len(v.OtherTransactions) > 0 {
+		out.OtherTransactions = make([]*TransactionIdentifier, len(v.OtherTransactions))
+		for i, item := range v.OtherTransactions {
+			if out.OtherTransactions[i], err = transactionIdentifierToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// blockResponseFromProto converts a *BlockResponse to a *types.BlockResponse.
+func blockResponseFromProto(v *BlockResponse) (*types.BlockResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.BlockResponse{}
+	if out.Block, err = blockFromProto(v.Block); err != nil {
+		return nil, err
+	}
+	if len(v.OtherTransactions) > 0 {
+		out.OtherTransactions = make([]*types.TransactionIdentifier, len(v.OtherTransactions))
+		for i, item := range v.OtherTransactions {
+			if out.OtherTransactions[i], err = transactionIdentifierFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// blockTransactionToProto converts a *types.BlockTransaction to a *BlockTransaction.
+func blockTransactionToProto(v *types.BlockTransaction) (*BlockTransaction, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &BlockTransaction{}
+	if out.BlockIdentifier, err = blockIdentifierToProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+	if out.Transaction, err = transactionToProto(v.Transaction); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// blockTransactionFromProto converts a *BlockTransaction to a *types.BlockTransaction.
+func blockTransactionFromProto(v *BlockTransaction) (*types.BlockTransaction, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.BlockTransaction{}
+	if out.BlockIdentifier, err = blockIdentifierFromProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+	if out.Transaction, err = transactionFromProto(v.Transaction); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// blockTransactionRequestToProto converts a *types.BlockTransactionRequest to a *BlockTransactionRequest.
+func blockTransactionRequestToProto(v *types.BlockTransactionRequest) (*BlockTransactionRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &BlockTransactionRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.BlockIdentifier, err = blockIdentifierToProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+	if out.TransactionIdentifier, err = transactionIdentifierToProto(v.TransactionIdentifier); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// blockTransactionRequestFromProto converts a *BlockTransactionRequest to a *types.BlockTransactionRequest.
+func blockTransactionRequestFromProto(v *BlockTransactionRequest) (*types.BlockTransactionRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.BlockTransactionRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.BlockIdentifier, err = blockIdentifierFromProto(v.BlockIdentifier); err != nil {
+		return nil, err
+	}
+	if out.TransactionIdentifier, err = transactionIdentifierFromProto(v.TransactionIdentifier); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// blockTransactionResponseToProto converts a *types.BlockTransactionResponse to a *BlockTransactionResponse.
+func blockTransactionResponseToProto(v *types.BlockTransactionResponse) (*BlockTransactionResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &BlockTransactionResponse{}
+	if out.Transaction, err = transactionToProto(v.Transaction); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// blockTransactionResponseFromProto converts a *BlockTransactionResponse to a *types.BlockTransactionResponse.
+func blockTransactionResponseFromProto(v *BlockTransactionResponse) (*types.BlockTransactionResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.BlockTransactionResponse{}
+	if out.Transaction, err = transactionFromProto(v.Transaction); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// callRequestToProto converts a *types.CallRequest to a *CallRequest.
+func callRequestToProto(v *types.CallRequest) (*CallRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &CallRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	out.Method = v.Method
+	if out.Parameters, err = metadataToProto(v.Parameters); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// callRequestFromProto converts a *CallRequest to a *types.CallRequest.
+func callRequestFromProto(v *CallRequest) (*types.CallRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.CallRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	out.Method = v.Method
+	if out.Parameters, err = metadataFromProto(v.Parameters); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// callResponseToProto converts a *types.CallResponse to a *CallResponse.
+func callResponseToProto(v *types.CallResponse) (*CallResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &CallResponse{}
+	if out.Result, err = metadataToProto(v.Result); err != nil {
+		return nil, err
+	}
+	out.Idempotent = v.Idempotent
+
+	return out, err
+}
+
+// callResponseFromProto converts a *CallResponse to a *types.CallResponse.
+func callResponseFromProto(v *CallResponse) (*types.CallResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.CallResponse{}
+	if out.Result, err = metadataFromProto(v.Result); err != nil {
+		return nil, err
+	}
+	out.Idempotent = v.Idempotent
+
+	return out, err
+}
+
+// coinToProto converts a *types.Coin to a *Coin.
+func coinToProto(v *types.Coin) (*Coin, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &Coin{}
+	if out.CoinIdentifier, err = coinIdentifierToProto(v.CoinIdentifier); err != nil {
+		return nil, err
+	}
+	if out.Amount, err = amountToProto(v.Amount); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// coinFromProto converts a *Coin to a *types.Coin.
+func coinFromProto(v *Coin) (*types.Coin, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.Coin{}
+	if out.CoinIdentifier, err = coinIdentifierFromProto(v.CoinIdentifier); err != nil {
+		return nil, err
+	}
+	if out.Amount, err = amountFromProto(v.Amount); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// coinChangeToProto converts a *types.CoinChange to a *CoinChange.
+func coinChangeToProto(v *types.CoinChange) (*CoinChange, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &CoinChange{}
+	if out.CoinIdentifier, err = coinIdentifierToProto(v.CoinIdentifier); err != nil {
+		return nil, err
+	}
+	out.CoinAction = string(v.CoinAction)
+
+	return out, err
+}
+
+// coinChangeFromProto converts a *CoinChange to a *types.CoinChange.
+func coinChangeFromProto(v *CoinChange) (*types.CoinChange, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.CoinChange{}
+	if out.CoinIdentifier, err = coinIdentifierFromProto(v.CoinIdentifier); err != nil {
+		return nil, err
+	}
+	out.CoinAction = types.CoinAction(v.CoinAction)
+
+	return out, err
+}
+
+// coinIdentifierToProto converts a *types.CoinIdentifier to a *CoinIdentifier.
+func coinIdentifierToProto(v *types.CoinIdentifier) (*CoinIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &CoinIdentifier{}
+	out.Identifier = v.Identifier
+
+	return out, err
+}
+
+// coinIdentifierFromProto converts a *CoinIdentifier to a *types.CoinIdentifier.
+func coinIdentifierFromProto(v *CoinIdentifier) (*types.CoinIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.CoinIdentifier{}
+	out.Identifier = v.Identifier
+
+	return out, err
+}
+
+// constructionCombineRequestToProto converts a *types.ConstructionCombineRequest to a *ConstructionCombineRequest.
+func constructionCombineRequestToProto(v *types.ConstructionCombineRequest) (*ConstructionCombineRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &ConstructionCombineRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	out.UnsignedTransaction = v.UnsignedTransaction
+	if len(v.Signatures) > 0 {
+		out.Signatures = make([]*Signature, len(v.Signatures))
+		for i, item := range v.Signatures {
+			if out.Signatures[i], err = signatureToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// constructionCombineRequestFromProto converts a *ConstructionCombineRequest to a *types.ConstructionCombineRequest.
+func constructionCombineRequestFromProto(v *ConstructionCombineRequest) (*types.ConstructionCombineRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.ConstructionCombineRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	out.UnsignedTransaction = v.UnsignedTransaction
+	if len(v.Signatures) > 0 {
+		out.Signatures = make([]*types.Signature, len(v.Signatures))
+		for i, item := range v.Signatures {
+			if out.Signatures[i], err = signatureFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// constructionCombineResponseToProto converts a *types.ConstructionCombineResponse to a *ConstructionCombineResponse.
+func constructionCombineResponseToProto(v *types.ConstructionCombineResponse) (*ConstructionCombineResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &ConstructionCombineResponse{}
+	out.SignedTransaction = v.SignedTransaction
+
+	return out, err
+}
+
+// constructionCombineResponseFromProto converts a *ConstructionCombineResponse to a *types.ConstructionCombineResponse.
+func constructionCombineResponseFromProto(v *ConstructionCombineResponse) (*types.ConstructionCombineResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.ConstructionCombineResponse{}
+	out.SignedTransaction = v.SignedTransaction
+
+	return out, err
+}
+
+// constructionDeriveRequestToProto converts a *types.ConstructionDeriveRequest to a *ConstructionDeriveRequest.
+func constructionDeriveRequestToProto(v *types.ConstructionDeriveRequest) (*ConstructionDeriveRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &ConstructionDeriveRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.PublicKey, err = publicKeyToProto(v.PublicKey); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// constructionDeriveRequestFromProto converts a *ConstructionDeriveRequest to a *types.ConstructionDeriveRequest.
+func constructionDeriveRequestFromProto(v *ConstructionDeriveRequest) (*types.ConstructionDeriveRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.ConstructionDeriveRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.PublicKey, err = publicKeyFromProto(v.PublicKey); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// constructionDeriveResponseToProto converts a *types.ConstructionDeriveResponse to a *ConstructionDeriveResponse.
+func constructionDeriveResponseToProto(v *types.ConstructionDeriveResponse) (*ConstructionDeriveResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &ConstructionDeriveResponse{}
+	if out.AccountIdentifier, err = accountIdentifierToProto(v.AccountIdentifier); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// constructionDeriveResponseFromProto converts a *ConstructionDeriveResponse to a *types.ConstructionDeriveResponse.
+func constructionDeriveResponseFromProto(v *ConstructionDeriveResponse) (*types.ConstructionDeriveResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.ConstructionDeriveResponse{}
+	if out.AccountIdentifier, err = accountIdentifierFromProto(v.AccountIdentifier); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// constructionHashRequestToProto converts a *types.ConstructionHashRequest to a *ConstructionHashRequest.
+func constructionHashRequestToProto(v *types.ConstructionHashRequest) (*ConstructionHashRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &ConstructionHashRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	out.SignedTransaction = v.SignedTransaction
+
+	return out, err
+}
+
+// constructionHashRequestFromProto converts a *ConstructionHashRequest to a *types.ConstructionHashRequest.
+func constructionHashRequestFromProto(v *ConstructionHashRequest) (*types.ConstructionHashRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.ConstructionHashRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	out.SignedTransaction = v.SignedTransaction
+
+	return out, err
+}
+
+// constructionMetadataRequestToProto converts a *types.ConstructionMetadataRequest to a *ConstructionMetadataRequest.
+func constructionMetadataRequestToProto(v *types.ConstructionMetadataRequest) (*ConstructionMetadataRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &ConstructionMetadataRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.Options, err = metadataToProto(v.Options); err != nil {
+		return nil, err
+	}
+	if len(v.PublicKeys) > 0 {
+		out.PublicKeys = make([]*PublicKey, len(v.PublicKeys))
+		for i, item := range v.PublicKeys {
+			if out.PublicKeys[i], err = publicKeyToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// constructionMetadataRequestFromProto converts a *ConstructionMetadataRequest to a *types.ConstructionMetadataRequest.
+func constructionMetadataRequestFromProto(v *ConstructionMetadataRequest) (*types.ConstructionMetadataRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.ConstructionMetadataRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.Options, err = metadataFromProto(v.Options); err != nil {
+		return nil, err
+	}
+	if len(v.PublicKeys) > 0 {
+		out.PublicKeys = make([]*types.PublicKey, len(v.PublicKeys))
+		for i, item := range v.PublicKeys {
+			if out.PublicKeys[i], err = publicKeyFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// constructionMetadataResponseToProto converts a *types.ConstructionMetadataResponse to a *ConstructionMetadataResponse.
+func constructionMetadataResponseToProto(v *types.ConstructionMetadataResponse) (*ConstructionMetadataResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &ConstructionMetadataResponse{}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+	if len(v.SuggestedFee) > 0 {
+		out.SuggestedFee = make([]*Amount, len(v.SuggestedFee))
+		for i, item := range v.SuggestedFee {
+			if out.SuggestedFee[i], err = amountToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// constructionMetadataResponseFromProto converts a *ConstructionMetadataResponse to a *types.ConstructionMetadataResponse.
+func constructionMetadataResponseFromProto(v *ConstructionMetadataResponse) (*types.ConstructionMetadataResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.ConstructionMetadataResponse{}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+	if len(v.SuggestedFee) > 0 {
+		out.SuggestedFee = make([]*types.Amount, len(v.SuggestedFee))
+		for i, item := range v.SuggestedFee {
+			if out.SuggestedFee[i], err = amountFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// constructionParseRequestToProto converts a *types.ConstructionParseRequest to a *ConstructionParseRequest.
+func constructionParseRequestToProto(v *types.ConstructionParseRequest) (*ConstructionParseRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &ConstructionParseRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	out.Signed = v.Signed
+	out.Transaction = v.Transaction
+
+	return out, err
+}
+
+// constructionParseRequestFromProto converts a *ConstructionParseRequest to a *types.ConstructionParseRequest.
+func constructionParseRequestFromProto(v *ConstructionParseRequest) (*types.ConstructionParseRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.ConstructionParseRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	out.Signed = v.Signed
+	out.Transaction = v.Transaction
+
+	return out, err
+}
+
+// constructionParseResponseToProto converts a *types.ConstructionParseResponse to a *ConstructionParseResponse.
+func constructionParseResponseToProto(v *types.ConstructionParseResponse) (*ConstructionParseResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &ConstructionParseResponse{}
+	if len(v.Operations) > 0 {
+		out.Operations = make([]*Operation, len(v.Operations))
+		for i, item := range v.Operations {
+			if out.Operations[i], err = operationToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(v.AccountIdentifierSigners) > 0 {
+		out.AccountIdentifierSigners = make([]*AccountIdentifier, len(v.AccountIdentifierSigners))
+		for i, item := range v.AccountIdentifierSigners {
+			if out.AccountIdentifierSigners[i], err = accountIdentifierToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// constructionParseResponseFromProto converts a *ConstructionParseResponse to a *types.ConstructionParseResponse.
+func constructionParseResponseFromProto(v *ConstructionParseResponse) (*types.ConstructionParseResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.ConstructionParseResponse{}
+	if len(v.Operations) > 0 {
+		out.Operations = make([]*types.Operation, len(v.Operations))
+		for i, item := range v.Operations {
+			if out.Operations[i], err = operationFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(v.AccountIdentifierSigners) > 0 {
+		out.AccountIdentifierSigners = make([]*types.AccountIdentifier, len(v.AccountIdentifierSigners))
+		for i, item := range v.AccountIdentifierSigners {
+			if out.AccountIdentifierSigners[i], err = accountIdentifierFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// constructionPayloadsRequestToProto converts a *types.ConstructionPayloadsRequest to a *ConstructionPayloadsRequest.
+func constructionPayloadsRequestToProto(v *types.ConstructionPayloadsRequest) (*ConstructionPayloadsRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &ConstructionPayloadsRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if len(v.Operations) > 0 {
+		out.Operations = make([]*Operation, len(v.Operations))
+		for i, item := range v.Operations {
+			if out.Operations[i], err = operationToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+	if len(v.PublicKeys) > 0 {
+		out.PublicKeys = make([]*PublicKey, len(v.PublicKeys))
+		for i, item := range v.PublicKeys {
+			if out.PublicKeys[i], err = publicKeyToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// constructionPayloadsRequestFromProto converts a *ConstructionPayloadsRequest to a *types.ConstructionPayloadsRequest.
+func constructionPayloadsRequestFromProto(v *ConstructionPayloadsRequest) (*types.ConstructionPayloadsRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.ConstructionPayloadsRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if len(v.Operations) > 0 {
+		out.Operations = make([]*types.Operation, len(v.Operations))
+		for i, item := range v.Operations {
+			if out.Operations[i], err = operationFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+	if len(v.PublicKeys) > 0 {
+		out.PublicKeys = make([]*types.PublicKey, len(v.PublicKeys))
+		for i, item := range v.PublicKeys {
+			if out.PublicKeys[i], err = publicKeyFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// constructionPayloadsResponseToProto converts a *types.ConstructionPayloadsResponse to a *ConstructionPayloadsResponse.
+func constructionPayloadsResponseToProto(v *types.ConstructionPayloadsResponse) (*ConstructionPayloadsResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &ConstructionPayloadsResponse{}
+	out.UnsignedTransaction = v.UnsignedTransaction
+	if len(v.Payloads) > 0 {
+		out.Payloads = make([]*SigningPayload, len(v.Payloads))
+		for i, item := range v.Payloads {
+			if out.Payloads[i], err = signingPayloadToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// constructionPayloadsResponseFromProto converts a *ConstructionPayloadsResponse to a *types.ConstructionPayloadsResponse.
+func constructionPayloadsResponseFromProto(v *ConstructionPayloadsResponse) (*types.ConstructionPayloadsResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.ConstructionPayloadsResponse{}
+	out.UnsignedTransaction = v.UnsignedTransaction
+	if len(v.Payloads) > 0 {
+		out.Payloads = make([]*types.SigningPayload, len(v.Payloads))
+		for i, item := range v.Payloads {
+			if out.Payloads[i], err = signingPayloadFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// constructionPreprocessRequestToProto converts a *types.ConstructionPreprocessRequest to a *ConstructionPreprocessRequest.
+func constructionPreprocessRequestToProto(v *types.ConstructionPreprocessRequest) (*ConstructionPreprocessRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &ConstructionPreprocessRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if len(v.Operations) > 0 {
+		out.Operations = make([]*Operation, len(v.Operations))
+		for i, item := range v.Operations {
+			if out.Operations[i], err = operationToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+	if len(v.MaxFee) > 0 {
+		out.MaxFee = make([]*Amount, len(v.MaxFee))
+		for i, item := range v.MaxFee {
+			if out.MaxFee[i], err = amountToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	out.SuggestedFeeMultiplier = v.SuggestedFeeMultiplier
+
+	return out, err
+}
+
+// constructionPreprocessRequestFromProto converts a *ConstructionPreprocessRequest to a *types.ConstructionPreprocessRequest.
+func constructionPreprocessRequestFromProto(v *ConstructionPreprocessRequest) (*types.ConstructionPreprocessRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.ConstructionPreprocessRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if len(v.Operations) > 0 {
+		out.Operations = make([]*types.Operation, len(v.Operations))
+		for i, item := range v.Operations {
+			if out.Operations[i], err = operationFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+	if len(v.MaxFee) > 0 {
+		out.MaxFee = make([]*types.Amount, len(v.MaxFee))
+		for i, item := range v.MaxFee {
+			if out.MaxFee[i], err = amountFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	out.SuggestedFeeMultiplier = v.SuggestedFeeMultiplier
+
+	return out, err
+}
+
+// constructionPreprocessResponseToProto converts a *types.ConstructionPreprocessResponse to a *ConstructionPreprocessResponse.
+func constructionPreprocessResponseToProto(v *types.ConstructionPreprocessResponse) (*ConstructionPreprocessResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &ConstructionPreprocessResponse{}
+	if out.Options, err = metadataToProto(v.Options); err != nil {
+		return nil, err
+	}
+	if len(v.RequiredPublicKeys) > 0 {
+		out.RequiredPublicKeys = make([]*AccountIdentifier, len(v.RequiredPublicKeys))
+		for i, item := range v.RequiredPublicKeys {
+			if out.RequiredPublicKeys[i], err = accountIdentifierToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// constructionPreprocessResponseFromProto converts a *ConstructionPreprocessResponse to a *types.ConstructionPreprocessResponse.
+func constructionPreprocessResponseFromProto(v *ConstructionPreprocessResponse) (*types.ConstructionPreprocessResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.ConstructionPreprocessResponse{}
+	if out.Options, err = metadataFromProto(v.Options); err != nil {
+		return nil, err
+	}
+	if len(v.RequiredPublicKeys) > 0 {
+		out.RequiredPublicKeys = make([]*types.AccountIdentifier, len(v.RequiredPublicKeys))
+		for i, item := range v.RequiredPublicKeys {
+			if out.RequiredPublicKeys[i], err = accountIdentifierFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// constructionSubmitRequestToProto converts a *types.ConstructionSubmitRequest to a *ConstructionSubmitRequest.
+func constructionSubmitRequestToProto(v *types.ConstructionSubmitRequest) (*ConstructionSubmitRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &ConstructionSubmitRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	out.SignedTransaction = v.SignedTransaction
+
+	return out, err
+}
+
+// constructionSubmitRequestFromProto converts a *ConstructionSubmitRequest to a *types.ConstructionSubmitRequest.
+func constructionSubmitRequestFromProto(v *ConstructionSubmitRequest) (*types.ConstructionSubmitRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.ConstructionSubmitRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	out.SignedTransaction = v.SignedTransaction
+
+	return out, err
+}
+
+// currencyToProto converts a *types.Currency to a *Currency.
+func currencyToProto(v *types.Currency) (*Currency, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &Currency{}
+	out.Symbol = v.Symbol
+	out.Decimals = v.Decimals
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// currencyFromProto converts a *Currency to a *types.Currency.
+func currencyFromProto(v *Currency) (*types.Currency, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.Currency{}
+	out.Symbol = v.Symbol
+	out.Decimals = v.Decimals
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// errorToProto converts a *types.Error to a *Error.
+func errorToProto(v *types.Error) (*Error, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &Error{}
+	out.Code = v.Code
+	out.Message = v.Message
+	out.Description = v.Description
+	out.Retriable = v.Retriable
+	if out.Details, err = metadataToProto(v.Details); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// errorFromProto converts a *Error to a *types.Error.
+func errorFromProto(v *Error) (*types.Error, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.Error{}
+	out.Code = v.Code
+	out.Message = v.Message
+	out.Description = v.Description
+	out.Retriable = v.Retriable
+	if out.Details, err = metadataFromProto(v.Details); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// eventsBlocksRequestToProto converts a *types.EventsBlocksRequest to a *EventsBlocksRequest.
+func eventsBlocksRequestToProto(v *types.EventsBlocksRequest) (*EventsBlocksRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &EventsBlocksRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	out.Offset = v.Offset
+	out.Limit = v.Limit
+
+	return out, err
+}
+
+// eventsBlocksRequestFromProto converts a *EventsBlocksRequest to a *types.EventsBlocksRequest.
+func eventsBlocksRequestFromProto(v *EventsBlocksRequest) (*types.EventsBlocksRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.EventsBlocksRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	out.Offset = v.Offset
+	out.Limit = v.Limit
+
+	return out, err
+}
+
+// eventsBlocksResponseToProto converts a *types.EventsBlocksResponse to a *EventsBlocksResponse.
+func eventsBlocksResponseToProto(v *types.EventsBlocksResponse) (*EventsBlocksResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &EventsBlocksResponse{}
+	out.MaxSequence = v.MaxSequence
+	if len(v.Events) > 0 {
+		out.Events = make([]*BlockEvent, len(v.Events))
+		for i, item := range v.Events {
+			if out.Events[i], err = blockEventToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// eventsBlocksResponseFromProto converts a *EventsBlocksResponse to a *types.EventsBlocksResponse.
+func eventsBlocksResponseFromProto(v *EventsBlocksResponse) (*types.EventsBlocksResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.EventsBlocksResponse{}
+	out.MaxSequence = v.MaxSequence
+	if len(v.Events) > 0 {
+		out.Events = make([]*types.BlockEvent, len(v.Events))
+		for i, item := range v.Events {
+			if out.Events[i], err = blockEventFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// mempoolResponseToProto converts a *types.MempoolResponse to a *MempoolResponse.
+func mempoolResponseToProto(v *types.MempoolResponse) (*MempoolResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &MempoolResponse{}
+	if len(v.TransactionIdentifiers) > 0 {
+		out.TransactionIdentifiers = make([]*TransactionIdentifier, len(v.TransactionIdentifiers))
+		for i, item := range v.TransactionIdentifiers {
+			if out.TransactionIdentifiers[i], err = transactionIdentifierToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// mempoolResponseFromProto converts a *MempoolResponse to a *types.MempoolResponse.
+func mempoolResponseFromProto(v *MempoolResponse) (*types.MempoolResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.MempoolResponse{}
+	if len(v.TransactionIdentifiers) > 0 {
+		out.TransactionIdentifiers = make([]*types.TransactionIdentifier, len(v.TransactionIdentifiers))
+		for i, item := range v.TransactionIdentifiers {
+			if out.TransactionIdentifiers[i], err = transactionIdentifierFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// mempoolTransactionRequestToProto converts a *types.MempoolTransactionRequest to a *MempoolTransactionRequest.
+func mempoolTransactionRequestToProto(v *types.MempoolTransactionRequest) (*MempoolTransactionRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &MempoolTransactionRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.TransactionIdentifier, err = transactionIdentifierToProto(v.TransactionIdentifier); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// mempoolTransactionRequestFromProto converts a *MempoolTransactionRequest to a *types.MempoolTransactionRequest.
+func mempoolTransactionRequestFromProto(v *MempoolTransactionRequest) (*types.MempoolTransactionRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.MempoolTransactionRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.TransactionIdentifier, err = transactionIdentifierFromProto(v.TransactionIdentifier); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// mempoolTransactionResponseToProto converts a *types.MempoolTransactionResponse to a *MempoolTransactionResponse.
+func mempoolTransactionResponseToProto(v *types.MempoolTransactionResponse) (*MempoolTransactionResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &MempoolTransactionResponse{}
+	if out.Transaction, err = transactionToProto(v.Transaction); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// mempoolTransactionResponseFromProto converts a *MempoolTransactionResponse to a *types.MempoolTransactionResponse.
+func mempoolTransactionResponseFromProto(v *MempoolTransactionResponse) (*types.MempoolTransactionResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.MempoolTransactionResponse{}
+	if out.Transaction, err = transactionFromProto(v.Transaction); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// metadataRequestToProto converts a *types.MetadataRequest to a *MetadataRequest.
+func metadataRequestToProto(v *types.MetadataRequest) (*MetadataRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &MetadataRequest{}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// metadataRequestFromProto converts a *MetadataRequest to a *types.MetadataRequest.
+func metadataRequestFromProto(v *MetadataRequest) (*types.MetadataRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.MetadataRequest{}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// networkIdentifierToProto converts a *types.NetworkIdentifier to a *NetworkIdentifier.
+func networkIdentifierToProto(v *types.NetworkIdentifier) (*NetworkIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &NetworkIdentifier{}
+	out.Blockchain = v.Blockchain
+	out.Network = v.Network
+	if out.SubNetworkIdentifier, err = subNetworkIdentifierToProto(v.SubNetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// networkIdentifierFromProto converts a *NetworkIdentifier to a *types.NetworkIdentifier.
+func networkIdentifierFromProto(v *NetworkIdentifier) (*types.NetworkIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.NetworkIdentifier{}
+	out.Blockchain = v.Blockchain
+	out.Network = v.Network
+	if out.SubNetworkIdentifier, err = subNetworkIdentifierFromProto(v.SubNetworkIdentifier); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// networkListResponseToProto converts a *types.NetworkListResponse to a *NetworkListResponse.
+func networkListResponseToProto(v *types.NetworkListResponse) (*NetworkListResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &NetworkListResponse{}
+	if len(v.NetworkIdentifiers) > 0 {
+		out.NetworkIdentifiers = make([]*NetworkIdentifier, len(v.NetworkIdentifiers))
+		for i, item := range v.NetworkIdentifiers {
+			if out.NetworkIdentifiers[i], err = networkIdentifierToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// networkListResponseFromProto converts a *NetworkListResponse to a *types.NetworkListResponse.
+func networkListResponseFromProto(v *NetworkListResponse) (*types.NetworkListResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.NetworkListResponse{}
+	if len(v.NetworkIdentifiers) > 0 {
+		out.NetworkIdentifiers = make([]*types.NetworkIdentifier, len(v.NetworkIdentifiers))
+		for i, item := range v.NetworkIdentifiers {
+			if out.NetworkIdentifiers[i], err = networkIdentifierFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// networkOptionsResponseToProto converts a *types.NetworkOptionsResponse to a *NetworkOptionsResponse.
+func networkOptionsResponseToProto(v *types.NetworkOptionsResponse) (*NetworkOptionsResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &NetworkOptionsResponse{}
+	if out.Version, err = versionToProto(v.Version); err != nil {
+		return nil, err
+	}
+	if out.Allow, err = allowToProto(v.Allow); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// networkOptionsResponseFromProto converts a *NetworkOptionsResponse to a *types.NetworkOptionsResponse.
+func networkOptionsResponseFromProto(v *NetworkOptionsResponse) (*types.NetworkOptionsResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.NetworkOptionsResponse{}
+	if out.Version, err = versionFromProto(v.Version); err != nil {
+		return nil, err
+	}
+	if out.Allow, err = allowFromProto(v.Allow); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// networkRequestToProto converts a *types.NetworkRequest to a *NetworkRequest.
+func networkRequestToProto(v *types.NetworkRequest) (*NetworkRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &NetworkRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// networkRequestFromProto converts a *NetworkRequest to a *types.NetworkRequest.
+func networkRequestFromProto(v *NetworkRequest) (*types.NetworkRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.NetworkRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// networkStatusResponseToProto converts a *types.NetworkStatusResponse to a *NetworkStatusResponse.
+func networkStatusResponseToProto(v *types.NetworkStatusResponse) (*NetworkStatusResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &NetworkStatusResponse{}
+	if out.CurrentBlockIdentifier, err = blockIdentifierToProto(v.CurrentBlockIdentifier); err != nil {
+		return nil, err
+	}
+	out.CurrentBlockTimestamp = v.CurrentBlockTimestamp
+	if out.GenesisBlockIdentifier, err = blockIdentifierToProto(v.GenesisBlockIdentifier); err != nil {
+		return nil, err
+	}
+	if out.OldestBlockIdentifier, err = blockIdentifierToProto(v.OldestBlockIdentifier); err != nil {
+		return nil, err
+	}
+	if out.SyncStatus, err = syncStatusToProto(v.SyncStatus); err != nil {
+		return nil, err
+	}
+	if len(v.Peers) > 0 {
+		out.Peers = make([]*Peer, len(v.Peers))
+		for i, item := range v.Peers {
+			if out.Peers[i], err = peerToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// networkStatusResponseFromProto converts a *NetworkStatusResponse to a *types.NetworkStatusResponse.
+func networkStatusResponseFromProto(v *NetworkStatusResponse) (*types.NetworkStatusResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.NetworkStatusResponse{}
+	if out.CurrentBlockIdentifier, err = blockIdentifierFromProto(v.CurrentBlockIdentifier); err != nil {
+		return nil, err
+	}
+	out.CurrentBlockTimestamp = v.CurrentBlockTimestamp
+	if out.GenesisBlockIdentifier, err = blockIdentifierFromProto(v.GenesisBlockIdentifier); err != nil {
+		return nil, err
+	}
+	if out.OldestBlockIdentifier, err = blockIdentifierFromProto(v.OldestBlockIdentifier); err != nil {
+		return nil, err
+	}
+	if out.SyncStatus, err = syncStatusFromProto(v.SyncStatus); err != nil {
+		return nil, err
+	}
+	if len(v.Peers) > 0 {
+		out.Peers = make([]*types.Peer, len(v.Peers))
+		for i, item := range v.Peers {
+			if out.Peers[i], err = peerFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return out, err
+}
+
+// operationToProto converts a *types.Operation to a *Operation.
+func operationToProto(v *types.Operation) (*Operation, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &Operation{}
+	if out.OperationIdentifier, err = operationIdentifierToProto(v.OperationIdentifier); err != nil {
+		return nil, err
+	}
+	if len(v.RelatedOperations) > 0 {
+		out.RelatedOperations = make([]*OperationIdentifier, len(v.RelatedOperations))
+		for i, item := range v.RelatedOperations {
+			if out.RelatedOperations[i], err = operationIdentifierToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	out.Type = v.Type
+	out.Status = v.Status
+	if out.Account, err = accountIdentifierToProto(v.Account); err != nil {
+		return nil, err
+	}
+	if out.Amount, err = amountToProto(v.Amount); err != nil {
+		return nil, err
+	}
+	if out.CoinChange, err = coinChangeToProto(v.CoinChange); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// operationFromProto converts a *Operation to a *types.Operation.
+func operationFromProto(v *Operation) (*types.Operation, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.Operation{}
+	if out.OperationIdentifier, err = operationIdentifierFromProto(v.OperationIdentifier); err != nil {
+		return nil, err
+	}
+	if len(v.RelatedOperations) > 0 {
+		out.RelatedOperations = make([]*types.OperationIdentifier, len(v.RelatedOperations))
+		for i, item := range v.RelatedOperations {
+			if out.RelatedOperations[i], err = operationIdentifierFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	out.Type = v.Type
+	out.Status = v.Status
+	if out.Account, err = accountIdentifierFromProto(v.Account); err != nil {
+		return nil, err
+	}
+	if out.Amount, err = amountFromProto(v.Amount); err != nil {
+		return nil, err
+	}
+	if out.CoinChange, err = coinChangeFromProto(v.CoinChange); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// operationIdentifierToProto converts a *types.OperationIdentifier to a *OperationIdentifier.
+func operationIdentifierToProto(v *types.OperationIdentifier) (*OperationIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &OperationIdentifier{}
+	out.Index = v.Index
+	out.NetworkIndex = v.NetworkIndex
+
+	return out, err
+}
+
+// operationIdentifierFromProto converts a *OperationIdentifier to a *types.OperationIdentifier.
+func operationIdentifierFromProto(v *OperationIdentifier) (*types.OperationIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.OperationIdentifier{}
+	out.Index = v.Index
+	out.NetworkIndex = v.NetworkIndex
+
+	return out, err
+}
+
+// operationStatusToProto converts a *types.OperationStatus to a *OperationStatus.
+func operationStatusToProto(v *types.OperationStatus) (*OperationStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &OperationStatus{}
+	out.Status = v.Status
+	out.Successful = v.Successful
+
+	return out, err
+}
+
+// operationStatusFromProto converts a *OperationStatus to a *types.OperationStatus.
+func operationStatusFromProto(v *OperationStatus) (*types.OperationStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.OperationStatus{}
+	out.Status = v.Status
+	out.Successful = v.Successful
+
+	return out, err
+}
+
+// partialBlockIdentifierToProto converts a *types.PartialBlockIdentifier to a *PartialBlockIdentifier.
+func partialBlockIdentifierToProto(v *types.PartialBlockIdentifier) (*PartialBlockIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &PartialBlockIdentifier{}
+	out.Index = v.Index
+	out.Hash = v.Hash
+
+	return out, err
+}
+
+// partialBlockIdentifierFromProto converts a *PartialBlockIdentifier to a *types.PartialBlockIdentifier.
+func partialBlockIdentifierFromProto(v *PartialBlockIdentifier) (*types.PartialBlockIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.PartialBlockIdentifier{}
+	out.Index = v.Index
+	out.Hash = v.Hash
+
+	return out, err
+}
+
+// peerToProto converts a *types.Peer to a *Peer.
+func peerToProto(v *types.Peer) (*Peer, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &Peer{}
+	out.PeerId = v.PeerID
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// peerFromProto converts a *Peer to a *types.Peer.
+func peerFromProto(v *Peer) (*types.Peer, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.Peer{}
+	out.PeerID = v.PeerId
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// publicKeyToProto converts a *types.PublicKey to a *PublicKey.
+func publicKeyToProto(v *types.PublicKey) (*PublicKey, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &PublicKey{}
+	out.HexBytes = v.Bytes
+	out.CurveType = string(v.CurveType)
+
+	return out, err
+}
+
+// publicKeyFromProto converts a *PublicKey to a *types.PublicKey.
+func publicKeyFromProto(v *PublicKey) (*types.PublicKey, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.PublicKey{}
+	out.Bytes = v.HexBytes
+	out.CurveType = types.CurveType(v.CurveType)
+
+	return out, err
+}
+
+// relatedTransactionToProto converts a *types.RelatedTransaction to a *RelatedTransaction.
+func relatedTransactionToProto(v *types.RelatedTransaction) (*RelatedTransaction, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &RelatedTransaction{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.TransactionIdentifier, err = transactionIdentifierToProto(v.TransactionIdentifier); err != nil {
+		return nil, err
+	}
+	out.Direction = string(v.Direction)
+
+	return out, err
+}
+
+// relatedTransactionFromProto converts a *RelatedTransaction to a *types.RelatedTransaction.
+func relatedTransactionFromProto(v *RelatedTransaction) (*types.RelatedTransaction, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.RelatedTransaction{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	if out.TransactionIdentifier, err = transactionIdentifierFromProto(v.TransactionIdentifier); err != nil {
+		return nil, err
+	}
+	out.Direction = types.Direction(v.Direction)
+
+	return out, err
+}
+
+// searchTransactionsRequestToProto converts a *types.SearchTransactionsRequest to a *SearchTransactionsRequest.
+func searchTransactionsRequestToProto(v *types.SearchTransactionsRequest) (*SearchTransactionsRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &SearchTransactionsRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierToProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	out.Operator = (*string)(v.Operator)
+	out.MaxBlock = v.MaxBlock
+	out.Offset = v.Offset
+	out.Limit = v.Limit
+	if out.TransactionIdentifier, err = transactionIdentifierToProto(v.TransactionIdentifier); err != nil {
+		return nil, err
+	}
+	if out.AccountIdentifier, err = accountIdentifierToProto(v.AccountIdentifier); err != nil {
+		return nil, err
+	}
+	if out.CoinIdentifier, err = coinIdentifierToProto(v.CoinIdentifier); err != nil {
+		return nil, err
+	}
+	if out.Currency, err = currencyToProto(v.Currency); err != nil {
+		return nil, err
+	}
+	out.Status = v.Status
+	out.Type = v.Type
+	out.Address = v.Address
+	out.Success = v.Success
+
+	return out, err
+}
+
+// searchTransactionsRequestFromProto converts a *SearchTransactionsRequest to a *types.SearchTransactionsRequest.
+func searchTransactionsRequestFromProto(v *SearchTransactionsRequest) (*types.SearchTransactionsRequest, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.SearchTransactionsRequest{}
+	if out.NetworkIdentifier, err = networkIdentifierFromProto(v.NetworkIdentifier); err != nil {
+		return nil, err
+	}
+	out.Operator = (*types.Operator)(v.Operator)
+	out.MaxBlock = v.MaxBlock
+	out.Offset = v.Offset
+	out.Limit = v.Limit
+	if out.TransactionIdentifier, err = transactionIdentifierFromProto(v.TransactionIdentifier); err != nil {
+		return nil, err
+	}
+	if out.AccountIdentifier, err = accountIdentifierFromProto(v.AccountIdentifier); err != nil {
+		return nil, err
+	}
+	if out.CoinIdentifier, err = coinIdentifierFromProto(v.CoinIdentifier); err != nil {
+		return nil, err
+	}
+	if out.Currency, err = currencyFromProto(v.Currency); err != nil {
+		return nil, err
+	}
+	out.Status = v.Status
+	out.Type = v.Type
+	out.Address = v.Address
+	out.Success = v.Success
+
+	return out, err
+}
+
+// searchTransactionsResponseToProto converts a *types.SearchTransactionsResponse to a *SearchTransactionsResponse.
+func searchTransactionsResponseToProto(v *types.SearchTransactionsResponse) (*SearchTransactionsResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &SearchTransactionsResponse{}
+	if len(v.Transactions) > 0 {
+		out.Transactions = make([]*BlockTransaction, len(v.Transactions))
+		for i, item := range v.Transactions {
+			if out.Transactions[i], err = blockTransactionToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	out.TotalCount = v.TotalCount
+	out.NextOffset = v.NextOffset
+
+	return out, err
+}
+
+// searchTransactionsResponseFromProto converts a *SearchTransactionsResponse to a *types.SearchTransactionsResponse.
+func searchTransactionsResponseFromProto(v *SearchTransactionsResponse) (*types.SearchTransactionsResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.SearchTransactionsResponse{}
+	if len(v.Transactions) > 0 {
+		out.Transactions = make([]*types.BlockTransaction, len(v.Transactions))
+		for i, item := range v.Transactions {
+			if out.Transactions[i], err = blockTransactionFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	out.TotalCount = v.TotalCount
+	out.NextOffset = v.NextOffset
+
+	return out, err
+}
+
+// signatureToProto converts a *types.Signature to a *Signature.
+func signatureToProto(v *types.Signature) (*Signature, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &Signature{}
+	if out.SigningPayload, err = signingPayloadToProto(v.SigningPayload); err != nil {
+		return nil, err
+	}
+	if out.PublicKey, err = publicKeyToProto(v.PublicKey); err != nil {
+		return nil, err
+	}
+	out.SignatureType = string(v.SignatureType)
+	out.HexBytes = v.Bytes
+
+	return out, err
+}
+
+// signatureFromProto converts a *Signature to a *types.Signature.
+func signatureFromProto(v *Signature) (*types.Signature, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.Signature{}
+	if out.SigningPayload, err = signingPayloadFromProto(v.SigningPayload); err != nil {
+		return nil, err
+	}
+	if out.PublicKey, err = publicKeyFromProto(v.PublicKey); err != nil {
+		return nil, err
+	}
+	out.SignatureType = types.SignatureType(v.SignatureType)
+	out.Bytes = v.HexBytes
+
+	return out, err
+}
+
+// signingPayloadToProto converts a *types.SigningPayload to a *SigningPayload.
+func signingPayloadToProto(v *types.SigningPayload) (*SigningPayload, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &SigningPayload{}
+	if out.AccountIdentifier, err = accountIdentifierToProto(v.AccountIdentifier); err != nil {
+		return nil, err
+	}
+	out.HexBytes = v.Bytes
+	out.SignatureType = string(v.SignatureType)
+
+	return out, err
+}
+
+// signingPayloadFromProto converts a *SigningPayload to a *types.SigningPayload.
+func signingPayloadFromProto(v *SigningPayload) (*types.SigningPayload, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.SigningPayload{}
+	if out.AccountIdentifier, err = accountIdentifierFromProto(v.AccountIdentifier); err != nil {
+		return nil, err
+	}
+	out.Bytes = v.HexBytes
+	out.SignatureType = types.SignatureType(v.SignatureType)
+
+	return out, err
+}
+
+// subAccountIdentifierToProto converts a *types.SubAccountIdentifier to a *SubAccountIdentifier.
+func subAccountIdentifierToProto(v *types.SubAccountIdentifier) (*SubAccountIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &SubAccountIdentifier{}
+	out.Address = v.Address
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// subAccountIdentifierFromProto converts a *SubAccountIdentifier to a *types.SubAccountIdentifier.
+func subAccountIdentifierFromProto(v *SubAccountIdentifier) (*types.SubAccountIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.SubAccountIdentifier{}
+	out.Address = v.Address
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// subNetworkIdentifierToProto converts a *types.SubNetworkIdentifier to a *SubNetworkIdentifier.
+func subNetworkIdentifierToProto(v *types.SubNetworkIdentifier) (*SubNetworkIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &SubNetworkIdentifier{}
+	out.Network = v.Network
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// subNetworkIdentifierFromProto converts a *SubNetworkIdentifier to a *types.SubNetworkIdentifier.
+func subNetworkIdentifierFromProto(v *SubNetworkIdentifier) (*types.SubNetworkIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.SubNetworkIdentifier{}
+	out.Network = v.Network
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// syncStatusToProto converts a *types.SyncStatus to a *SyncStatus.
+func syncStatusToProto(v *types.SyncStatus) (*SyncStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &SyncStatus{}
+	out.CurrentIndex = v.CurrentIndex
+	out.TargetIndex = v.TargetIndex
+	out.Stage = v.Stage
+	out.Synced = v.Synced
+
+	return out, err
+}
+
+// syncStatusFromProto converts a *SyncStatus to a *types.SyncStatus.
+func syncStatusFromProto(v *SyncStatus) (*types.SyncStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.SyncStatus{}
+	out.CurrentIndex = v.CurrentIndex
+	out.TargetIndex = v.TargetIndex
+	out.Stage = v.Stage
+	out.Synced = v.Synced
+
+	return out, err
+}
+
+// transactionToProto converts a *types.Transaction to a *Transaction.
+func transactionToProto(v *types.Transaction) (*Transaction, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &Transaction{}
+	if out.TransactionIdentifier, err = transactionIdentifierToProto(v.TransactionIdentifier); err != nil {
+		return nil, err
+	}
+	if len(v.Operations) > 0 {
+		out.Operations = make([]*Operation, len(v.Operations))
+		for i, item := range v.Operations {
+			if out.Operations[i], err = operationToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(v.RelatedTransactions) > 0 {
+		out.RelatedTransactions = make([]*RelatedTransaction, len(v.RelatedTransactions))
+		for i, item := range v.RelatedTransactions {
+			if out.RelatedTransactions[i], err = relatedTransactionToProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// transactionFromProto converts a *Transaction to a *types.Transaction.
+func transactionFromProto(v *Transaction) (*types.Transaction, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.Transaction{}
+	if out.TransactionIdentifier, err = transactionIdentifierFromProto(v.TransactionIdentifier); err != nil {
+		return nil, err
+	}
+	if len(v.Operations) > 0 {
+		out.Operations = make([]*types.Operation, len(v.Operations))
+		for i, item := range v.Operations {
+			if out.Operations[i], err = operationFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(v.RelatedTransactions) > 0 {
+		out.RelatedTransactions = make([]*types.RelatedTransaction, len(v.RelatedTransactions))
+		for i, item := range v.RelatedTransactions {
+			if out.RelatedTransactions[i], err = relatedTransactionFromProto(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// transactionIdentifierToProto converts a *types.TransactionIdentifier to a *TransactionIdentifier.
+func transactionIdentifierToProto(v *types.TransactionIdentifier) (*TransactionIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &TransactionIdentifier{}
+	out.Hash = v.Hash
+
+	return out, err
+}
+
+// transactionIdentifierFromProto converts a *TransactionIdentifier to a *types.TransactionIdentifier.
+func transactionIdentifierFromProto(v *TransactionIdentifier) (*types.TransactionIdentifier, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.TransactionIdentifier{}
+	out.Hash = v.Hash
+
+	return out, err
+}
+
+// transactionIdentifierResponseToProto converts a *types.TransactionIdentifierResponse to a *TransactionIdentifierResponse.
+func transactionIdentifierResponseToProto(v *types.TransactionIdentifierResponse) (*TransactionIdentifierResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &TransactionIdentifierResponse{}
+	if out.TransactionIdentifier, err = transactionIdentifierToProto(v.TransactionIdentifier); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// transactionIdentifierResponseFromProto converts a *TransactionIdentifierResponse to a *types.TransactionIdentifierResponse.
+func transactionIdentifierResponseFromProto(v *TransactionIdentifierResponse) (*types.TransactionIdentifierResponse, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.TransactionIdentifierResponse{}
+	if out.TransactionIdentifier, err = transactionIdentifierFromProto(v.TransactionIdentifier); err != nil {
+		return nil, err
+	}
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// versionToProto converts a *types.Version to a *Version.
+func versionToProto(v *types.Version) (*Version, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &Version{}
+	out.RosettaVersion = v.RosettaVersion
+	out.NodeVersion = v.NodeVersion
+	out.MiddlewareVersion = v.MiddlewareVersion
+	if out.Metadata, err = metadataToProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
+
+// versionFromProto converts a *Version to a *types.Version.
+func versionFromProto(v *Version) (*types.Version, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var err error
+	out := &types.Version{}
+	out.RosettaVersion = v.RosettaVersion
+	out.NodeVersion = v.NodeVersion
+	out.MiddlewareVersion = v.MiddlewareVersion
+	if out.Metadata, err = metadataFromProto(v.Metadata); err != nil {
+		return nil, err
+	}
+
+	return out, err
+}
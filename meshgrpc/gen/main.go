@@ -0,0 +1,598 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// gen generates the protobuf definitions, type conversions, server
+// adapters, and client used by meshgrpc from the types and server
+// packages.
+//
+// Run it from the root of the repository with `make gen-grpc`.
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	"github.com/dominant-strategies/mesh-sdk-go/server"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+const (
+	protoPackage = "mesh"
+	goPackage    = "github.com/dominant-strategies/mesh-sdk-go/meshgrpc"
+	typesPackage = "github.com/dominant-strategies/mesh-sdk-go/types"
+
+	generatedHeader = "// Code generated by meshgrpc/gen. DO NOT EDIT.\n\n"
+)
+
+// service is a gRPC service generated from
+// a server.*APIServicer.
+type service struct {
+	name       string
+	servicer   reflect.Type
+	routes     server.Routes
+	methods    []*method
+	goServicer string
+}
+
+// method is an rpc of a service.
+type method struct {
+	name     string
+	path     string
+	request  reflect.Type
+	response reflect.Type
+}
+
+var (
+	contextType  = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType    = reflect.TypeOf(&types.Error{})
+	metadataType = reflect.TypeOf(map[string]interface{}{})
+	asserterType = reflect.TypeOf(&asserter.Asserter{})
+)
+
+func services() []*service {
+	return []*service{
+		{
+			name:       "AccountAPI",
+			servicer:   reflect.TypeOf((*server.AccountAPIServicer)(nil)).Elem(),
+			routes:     server.NewAccountAPIController(nil, nil).Routes(),
+			goServicer: "AccountAPIServicer",
+		},
+		{
+			name:       "BlockAPI",
+			servicer:   reflect.TypeOf((*server.BlockAPIServicer)(nil)).Elem(),
+			routes:     server.NewBlockAPIController(nil, nil).Routes(),
+			goServicer: "BlockAPIServicer",
+		},
+		{
+			name:       "CallAPI",
+			servicer:   reflect.TypeOf((*server.CallAPIServicer)(nil)).Elem(),
+			routes:     server.NewCallAPIController(nil, nil).Routes(),
+			goServicer: "CallAPIServicer",
+		},
+		{
+			name:       "ConstructionAPI",
+			servicer:   reflect.TypeOf((*server.ConstructionAPIServicer)(nil)).Elem(),
+			routes:     server.NewConstructionAPIController(nil, nil).Routes(),
+			goServicer: "ConstructionAPIServicer",
+		},
+		{
+			name:       "EventsAPI",
+			servicer:   reflect.TypeOf((*server.EventsAPIServicer)(nil)).Elem(),
+			routes:     server.NewEventsAPIController(nil, nil).Routes(),
+			goServicer: "EventsAPIServicer",
+		},
+		{
+			name:       "MempoolAPI",
+			servicer:   reflect.TypeOf((*server.MempoolAPIServicer)(nil)).Elem(),
+			routes:     server.NewMempoolAPIController(nil, nil).Routes(),
+			goServicer: "MempoolAPIServicer",
+		},
+		{
+			name:       "NetworkAPI",
+			servicer:   reflect.TypeOf((*server.NetworkAPIServicer)(nil)).Elem(),
+			routes:     server.NewNetworkAPIController(nil, nil).Routes(),
+			goServicer: "NetworkAPIServicer",
+		},
+		{
+			name:       "SearchAPI",
+			servicer:   reflect.TypeOf((*server.SearchAPIServicer)(nil)).Elem(),
+			routes:     server.NewSearchAPIController(nil, nil).Routes(),
+			goServicer: "SearchAPIServicer",
+		},
+	}
+}
+
+// loadMethods populates the methods of each service and
+// returns all message types they reference.
+func loadMethods(services []*service) map[string]reflect.Type {
+	messages := map[string]reflect.Type{}
+	for _, s := range services {
+		paths := map[string]string{}
+		for _, route := range s.routes {
+			paths[route.Name] = route.Pattern
+		}
+
+		for i := 0; i < s.servicer.NumMethod(); i++ {
+			m := s.servicer.Method(i)
+			if m.Type.NumIn() != 2 || m.Type.In(0) != contextType ||
+				m.Type.NumOut() != 2 || m.Type.Out(1) != errorType {
+				log.Fatalf("%s.%s has an unsupported signature", s.name, m.Name)
+			}
+
+			path, ok := paths[m.Name]
+			if !ok {
+				log.Fatalf("%s.%s has no route", s.name, m.Name)
+			}
+
+			request := m.Type.In(1).Elem()
+			if _, ok := asserterType.MethodByName(request.Name()); !ok {
+				log.Fatalf("asserter has no method for %s", request.Name())
+			}
+
+			s.methods = append(s.methods, &method{
+				name:     m.Name,
+				path:     path,
+				request:  request,
+				response: m.Type.Out(0).Elem(),
+			})
+
+			addMessage(messages, request)
+			addMessage(messages, m.Type.Out(0).Elem())
+		}
+	}
+
+	addMessage(messages, errorType.Elem())
+
+	return messages
+}
+
+func addMessage(messages map[string]reflect.Type, t reflect.Type) {
+	if _, ok := messages[t.Name()]; ok {
+		return
+	}
+	messages[t.Name()] = t
+
+	for _, field := range fields(t) {
+		switch {
+		case field.Type.Kind() == reflect.Ptr && field.Type.Elem().Kind() == reflect.Struct:
+			addMessage(messages, field.Type.Elem())
+		case field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.Ptr:
+			addMessage(messages, field.Type.Elem().Elem())
+		}
+	}
+}
+
+// fields returns the serialized fields of a struct.
+func fields(t reflect.Type) []reflect.StructField {
+	fields := []reflect.StructField{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if len(jsonName(field)) == 0 {
+			continue
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+func jsonName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "-" {
+		return ""
+	}
+
+	return name
+}
+
+// goCamelCase returns the name protoc-gen-go uses
+// for a field (copied from google.golang.org/protobuf/internal/strs).
+func goCamelCase(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '.' && i+1 < len(s) && isASCIILower(s[i+1]):
+		case c == '.':
+			b = append(b, '_')
+		case c == '_' && (i == 0 || s[i-1] == '.'):
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(s) && isASCIILower(s[i+1]):
+		case isASCIIDigit(c):
+			b = append(b, c)
+		default:
+			if isASCIILower(c) {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+
+			for ; i+1 < len(s) && isASCIILower(s[i+1]); i++ {
+				b = append(b, s[i+1])
+			}
+		}
+	}
+
+	return string(b)
+}
+
+func isASCIILower(c byte) bool {
+	return 'a' <= c && c <= 'z'
+}
+
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func lowerFirst(s string) string {
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+func scalarProtoType(kind reflect.Kind) (string, bool) {
+	switch kind {
+	case reflect.String:
+		return "string", true
+	case reflect.Int64:
+		return "int64", true
+	case reflect.Int32:
+		return "int32", true
+	case reflect.Bool:
+		return "bool", true
+	case reflect.Float64:
+		return "double", true
+	default:
+		return "", false
+	}
+}
+
+// protoField returns the proto declaration
+// of a field (without its name and number).
+func protoField(t reflect.Type) string {
+	if scalar, ok := scalarProtoType(t.Kind()); ok {
+		return scalar
+	}
+
+	switch {
+	case t == metadataType:
+		return "google.protobuf.Struct"
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		return "bytes"
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String:
+		return "repeated string"
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Ptr &&
+		t.Elem().Elem().Kind() == reflect.Struct:
+		return "repeated " + t.Elem().Elem().Name()
+	case t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct:
+		return t.Elem().Name()
+	case t.Kind() == reflect.Ptr:
+		if scalar, ok := scalarProtoType(t.Elem().Kind()); ok {
+			return "optional " + scalar
+		}
+	}
+
+	log.Fatalf("unsupported type %s", t)
+	return ""
+}
+
+func sortedNames(messages map[string]reflect.Type) []string {
+	names := make([]string, 0, len(messages))
+	for name := range messages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func writeProto(dir string, services []*service, messages map[string]reflect.Type) {
+	var b bytes.Buffer
+	// protoc-gen-go copies the comments above the syntax and
+	// package statements (the license) into the generated files,
+	// so the header is written below them.
+	fmt.Fprintf(&b, "syntax = \"proto3\";\n\npackage %s;\n\n", protoPackage)
+	b.WriteString(generatedHeader)
+	fmt.Fprintf(&b, "import \"google/protobuf/struct.proto\";\n\n")
+	fmt.Fprintf(&b, "option go_package = \"%s\";\n", goPackage)
+
+	for _, s := range services {
+		fmt.Fprintf(&b, "\n// %s is generated from server.%s.\n", s.name, s.goServicer)
+		fmt.Fprintf(&b, "service %s {\n", s.name)
+		for _, m := range s.methods {
+			fmt.Fprintf(&b, "  // %s serves %s.\n", m.name, m.path)
+			fmt.Fprintf(
+				&b,
+				"  rpc %s(%s) returns (%s);\n",
+				m.name,
+				m.request.Name(),
+				m.response.Name(),
+			)
+		}
+		fmt.Fprintf(&b, "}\n")
+	}
+
+	for _, name := range sortedNames(messages) {
+		fmt.Fprintf(&b, "\n// %s is generated from types.%s.\n", name, name)
+		fmt.Fprintf(&b, "message %s {\n", name)
+		for i, field := range fields(messages[name]) {
+			fmt.Fprintf(&b, "  %s %s = %d;\n", protoField(field.Type), jsonName(field), i+1)
+		}
+		fmt.Fprintf(&b, "}\n")
+	}
+
+	write(path.Join(dir, "mesh.proto"), b.Bytes(), false)
+}
+
+// namedScalar returns a boolean indicating if t is a
+// scalar type declared in the types package.
+func namedScalar(t reflect.Type) bool {
+	return t.PkgPath() == typesPackage && t.Kind() != reflect.Struct
+}
+
+// convertField writes the conversion of a single field. The
+// source is v (either a *types.X or a *X) and the destination
+// is out.
+func convertField(b *bytes.Buffer, field reflect.StructField, toProto bool) {
+	typesName := field.Name
+	protoName := goCamelCase(jsonName(field))
+	src, dst := "v."+typesName, "out."+protoName
+	if !toProto {
+		src, dst = "v."+protoName, "out."+typesName
+	}
+
+	direction := "ToProto"
+	if !toProto {
+		direction = "FromProto"
+	}
+
+	t := field.Type
+	switch {
+	case t == metadataType:
+		fmt.Fprintf(b, "if %s, err = metadata%s(%s); err != nil {\nreturn nil, err\n}\n", dst, direction, src)
+	case t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct:
+		fmt.Fprintf(
+			b,
+			"if %s, err = %s%s(%s); err != nil {\nreturn nil, err\n}\n",
+			dst,
+			lowerFirst(t.Elem().Name()),
+			direction,
+			src,
+		)
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Ptr:
+		elem := t.Elem().Elem().Name()
+		elemType := elem
+		if !toProto {
+			elemType = "types." + elem
+		}
+		fmt.Fprintf(b, "if len(%s) > 0 {\n", src)
+		fmt.Fprintf(b, "%s = make([]*%s, len(%s))\n", dst, elemType, src)
+		fmt.Fprintf(b, "for i, item := range %s {\n", src)
+		fmt.Fprintf(
+			b,
+			"if %s[i], err = %s%s(item); err != nil {\nreturn nil, err\n}\n",
+			dst,
+			lowerFirst(elem),
+			direction,
+		)
+		fmt.Fprintf(b, "}\n}\n")
+	case t.Kind() == reflect.Ptr && namedScalar(t.Elem()):
+		if toProto {
+			fmt.Fprintf(b, "%s = (*%s)(%s)\n", dst, t.Elem().Kind(), src)
+		} else {
+			fmt.Fprintf(b, "%s = (*types.%s)(%s)\n", dst, t.Elem().Name(), src)
+		}
+	case namedScalar(t):
+		if toProto {
+			fmt.Fprintf(b, "%s = %s(%s)\n", dst, t.Kind(), src)
+		} else {
+			fmt.Fprintf(b, "%s = types.%s(%s)\n", dst, t.Name(), src)
+		}
+	default:
+		fmt.Fprintf(b, "%s = %s\n", dst, src)
+	}
+}
+
+func writeConversions(dir string, messages map[string]reflect.Type) {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader)
+	b.WriteString("package meshgrpc\n\n")
+	fmt.Fprintf(&b, "import (\n\"%s\"\n)\n", typesPackage)
+
+	for _, name := range sortedNames(messages) {
+		t := messages[name]
+		for _, toProto := range []bool{true, false} {
+			in, out, direction := "*types."+name, "*"+name, "ToProto"
+			if !toProto {
+				in, out, direction = out, in, "FromProto"
+			}
+
+			fmt.Fprintf(&b, "\n// %s%s converts a %s to a %s.\n", lowerFirst(name), direction, in, out)
+			fmt.Fprintf(&b, "func %s%s(v %s) (%s, error) {\n", lowerFirst(name), direction, in, out)
+			fmt.Fprintf(&b, "if v == nil {\nreturn nil, nil\n}\n\n")
+			fmt.Fprintf(&b, "var err error\nout := &%s{}\n", strings.TrimPrefix(out, "*"))
+			for _, field := range fields(t) {
+				convertField(&b, field, toProto)
+			}
+			fmt.Fprintf(&b, "\nreturn out, err\n}\n")
+		}
+	}
+
+	write(path.Join(dir, "convert.go"), b.Bytes(), true)
+}
+
+func writeServers(dir string, services []*service) {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader)
+	b.WriteString("package meshgrpc\n\n")
+	b.WriteString(`import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	"github.com/dominant-strategies/mesh-sdk-go/server"
+)
+`)
+
+	for _, s := range services {
+		impl := lowerFirst(s.name) + "Server"
+		fmt.Fprintf(&b, "\n// %s implements %sServer using a server.%s.\n", impl, s.name, s.goServicer)
+		fmt.Fprintf(&b, "type %s struct {\nUnimplemented%sServer\n\n", impl, s.name)
+		fmt.Fprintf(&b, "servicer server.%s\nasserter *asserter.Asserter\n}\n", s.goServicer)
+
+		fmt.Fprintf(&b, "\n// Register%s registers a server.%s with a gRPC\n", s.goServicer, s.goServicer)
+		fmt.Fprintf(&b, "// server. Requests are validated with the *asserter.Asserter before\n")
+		fmt.Fprintf(&b, "// they are passed to the servicer (like the HTTP controllers).\n")
+		fmt.Fprintf(&b, "func Register%s(\nregistrar grpc.ServiceRegistrar,\n", s.goServicer)
+		fmt.Fprintf(&b, "servicer server.%s,\nasserter *asserter.Asserter,\n) {\n", s.goServicer)
+		fmt.Fprintf(&b, "Register%sServer(registrar, &%s{\nservicer: servicer,\nasserter: asserter,\n})\n}\n", s.name, impl)
+
+		for _, m := range s.methods {
+			request, response := m.request.Name(), m.response.Name()
+			fmt.Fprintf(&b, "\n// %s serves %s.\n", m.name, m.path)
+			fmt.Fprintf(
+				&b,
+				"func (s *%s) %s(ctx context.Context, req *%s) (*%s, error) {\n",
+				impl,
+				m.name,
+				request,
+				response,
+			)
+			fmt.Fprintf(&b, "request, err := %sFromProto(req)\n", lowerFirst(request))
+			fmt.Fprintf(&b, "if err != nil {\nreturn nil, invalidRequestStatus(err)\n}\n\n")
+			fmt.Fprintf(&b, "if err := s.asserter.%s(request); err != nil {\n", request)
+			fmt.Fprintf(&b, "return nil, invalidRequestStatus(err)\n}\n\n")
+			fmt.Fprintf(&b, "result, serviceErr := s.servicer.%s(ctx, request)\n", m.name)
+			fmt.Fprintf(&b, "if serviceErr != nil {\nreturn nil, errorStatus(serviceErr)\n}\n\n")
+			fmt.Fprintf(&b, "response, err := %sToProto(result)\n", lowerFirst(response))
+			fmt.Fprintf(&b, "if err != nil {\nreturn nil, status.Error(codes.Internal, err.Error())\n}\n\n")
+			fmt.Fprintf(&b, "return response, nil\n}\n")
+		}
+	}
+
+	write(path.Join(dir, "server.go"), b.Bytes(), true)
+}
+
+func writeClient(dir string, services []*service) {
+	var b bytes.Buffer
+	b.WriteString(generatedHeader)
+	b.WriteString("package meshgrpc\n\n")
+	b.WriteString(`import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+`)
+
+	b.WriteString("\n// Client invokes the Mesh API over a gRPC connection. Its\n")
+	b.WriteString("// methods mirror those of client.APIClient.\n")
+	b.WriteString("type Client struct {\n")
+	for _, s := range services {
+		fmt.Fprintf(&b, "%s %sClient\n", lowerFirst(s.name), s.name)
+	}
+	b.WriteString("}\n")
+
+	b.WriteString("\n// NewClient returns a new *Client using a gRPC connection.\n")
+	b.WriteString("func NewClient(conn grpc.ClientConnInterface) *Client {\nreturn &Client{\n")
+	for _, s := range services {
+		fmt.Fprintf(&b, "%s: New%sClient(conn),\n", lowerFirst(s.name), s.name)
+	}
+	b.WriteString("}\n}\n")
+
+	for _, s := range services {
+		for _, m := range s.methods {
+			request, response := m.request.Name(), m.response.Name()
+			fmt.Fprintf(&b, "\n// %s invokes %s.\n", m.name, m.path)
+			fmt.Fprintf(
+				&b,
+				"func (c *Client) %s(\nctx context.Context,\nrequest *types.%s,\n) (*types.%s, *types.Error, error) {\n",
+				m.name,
+				request,
+				response,
+			)
+			fmt.Fprintf(&b, "req, err := %sToProto(request)\n", lowerFirst(request))
+			fmt.Fprintf(&b, "if err != nil {\nreturn nil, nil, err\n}\n\n")
+			fmt.Fprintf(&b, "resp, err := c.%s.%s(ctx, req)\n", lowerFirst(s.name), m.name)
+			fmt.Fprintf(&b, "if err != nil {\nclientErr, err := statusError(err)\nreturn nil, clientErr, err\n}\n\n")
+			fmt.Fprintf(&b, "response, err := %sFromProto(resp)\n", lowerFirst(response))
+			fmt.Fprintf(&b, "if err != nil {\nreturn nil, nil, err\n}\n\n")
+			fmt.Fprintf(&b, "return response, nil, nil\n}\n")
+		}
+	}
+
+	b.WriteString("\n// routes invokes the *Client method for each\n")
+	b.WriteString("// Mesh path with a JSON request body.\n")
+	b.WriteString("var routes = map[string]func(\nctx context.Context,\nc *Client,\nbody []byte,\n) (interface{}, *types.Error, error){\n")
+	for _, s := range services {
+		for _, m := range s.methods {
+			fmt.Fprintf(&b, "\"%s\": func(ctx context.Context, c *Client, body []byte) (interface{}, *types.Error, error) {\n", m.path)
+			fmt.Fprintf(&b, "request := &types.%s{}\n", m.request.Name())
+			fmt.Fprintf(&b, "if err := json.Unmarshal(body, request); err != nil {\nreturn nil, nil, err\n}\n\n")
+			fmt.Fprintf(&b, "response, clientErr, err := c.%s(ctx, request)\n", m.name)
+			fmt.Fprintf(&b, "if err != nil {\nreturn nil, clientErr, err\n}\n\n")
+			fmt.Fprintf(&b, "return response, nil, nil\n},\n")
+		}
+	}
+	b.WriteString("}\n")
+
+	write(path.Join(dir, "client.go"), b.Bytes(), true)
+}
+
+func write(file string, contents []byte, gofmt bool) {
+	license, err := os.ReadFile(path.Join(path.Dir(file), "gen", "license.txt"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if gofmt {
+		contents, err = format.Source(contents)
+		if err != nil {
+			log.Fatalf("unable to format %s: %v", file, err)
+		}
+	}
+
+	if err := os.WriteFile(file, append(license, contents...), 0600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func main() {
+	dir := flag.String("dir", "meshgrpc", "output directory")
+	flag.Parse()
+
+	services := services()
+	messages := loadMethods(services)
+
+	writeProto(*dir, services, messages)
+	writeConversions(*dir, messages)
+	writeServers(*dir, services)
+	writeClient(*dir, services)
+}
@@ -0,0 +1,1377 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: mesh.proto
+
+package meshgrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AccountAPI_AccountBalance_FullMethodName = "/mesh.AccountAPI/AccountBalance"
+	AccountAPI_AccountCoins_FullMethodName   = "/mesh.AccountAPI/AccountCoins"
+)
+
+// AccountAPIClient is the client API for AccountAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AccountAPI is generated from server.AccountAPIServicer.
+type AccountAPIClient interface {
+	// AccountBalance serves /account/balance.
+	AccountBalance(ctx context.Context, in *AccountBalanceRequest, opts ...grpc.CallOption) (*AccountBalanceResponse, error)
+	// AccountCoins serves /account/coins.
+	AccountCoins(ctx context.Context, in *AccountCoinsRequest, opts ...grpc.CallOption) (*AccountCoinsResponse, error)
+}
+
+type accountAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccountAPIClient(cc grpc.ClientConnInterface) AccountAPIClient {
+	return &accountAPIClient{cc}
+}
+
+func (c *accountAPIClient) AccountBalance(ctx context.Context, in *AccountBalanceRequest, opts ...grpc.CallOption) (*AccountBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountBalanceResponse)
+	err := c.cc.Invoke(ctx, AccountAPI_AccountBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) AccountCoins(ctx context.Context, in *AccountCoinsRequest, opts ...grpc.CallOption) (*AccountCoinsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountCoinsResponse)
+	err := c.cc.Invoke(ctx, AccountAPI_AccountCoins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountAPIServer is the server API for AccountAPI service.
+// All implementations must embed UnimplementedAccountAPIServer
+// for forward compatibility.
+//
+// AccountAPI is generated from server.AccountAPIServicer.
+type AccountAPIServer interface {
+	// AccountBalance serves /account/balance.
+	AccountBalance(context.Context, *AccountBalanceRequest) (*AccountBalanceResponse, error)
+	// AccountCoins serves /account/coins.
+	AccountCoins(context.Context, *AccountCoinsRequest) (*AccountCoinsResponse, error)
+	mustEmbedUnimplementedAccountAPIServer()
+}
+
+// UnimplementedAccountAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAccountAPIServer struct{}
+
+func (UnimplementedAccountAPIServer) AccountBalance(context.Context, *AccountBalanceRequest) (*AccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountBalance not implemented")
+}
+func (UnimplementedAccountAPIServer) AccountCoins(context.Context, *AccountCoinsRequest) (*AccountCoinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountCoins not implemented")
+}
+func (UnimplementedAccountAPIServer) mustEmbedUnimplementedAccountAPIServer() {}
+func (UnimplementedAccountAPIServer) testEmbeddedByValue()                    {}
+
+// UnsafeAccountAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccountAPIServer will
+// result in compilation errors.
+type UnsafeAccountAPIServer interface {
+	mustEmbedUnimplementedAccountAPIServer()
+}
+
+func RegisterAccountAPIServer(s grpc.ServiceRegistrar, srv AccountAPIServer) {
+	// If the following call pancis, it indicates UnimplementedAccountAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AccountAPI_ServiceDesc, srv)
+}
+
+func _AccountAPI_AccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).AccountBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountAPI_AccountBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).AccountBalance(ctx, req.(*AccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_AccountCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountCoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).AccountCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountAPI_AccountCoins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).AccountCoins(ctx, req.(*AccountCoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccountAPI_ServiceDesc is the grpc.ServiceDesc for AccountAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccountAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mesh.AccountAPI",
+	HandlerType: (*AccountAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AccountBalance",
+			Handler:    _AccountAPI_AccountBalance_Handler,
+		},
+		{
+			MethodName: "AccountCoins",
+			Handler:    _AccountAPI_AccountCoins_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mesh.proto",
+}
+
+const (
+	BlockAPI_Block_FullMethodName            = "/mesh.BlockAPI/Block"
+	BlockAPI_BlockTransaction_FullMethodName = "/mesh.BlockAPI/BlockTransaction"
+)
+
+// BlockAPIClient is the client API for BlockAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BlockAPI is generated from server.BlockAPIServicer.
+type BlockAPIClient interface {
+	// Block serves /block.
+	Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// BlockTransaction serves /block/transaction.
+	BlockTransaction(ctx context.Context, in *BlockTransactionRequest, opts ...grpc.CallOption) (*BlockTransactionResponse, error)
+}
+
+type blockAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBlockAPIClient(cc grpc.ClientConnInterface) BlockAPIClient {
+	return &blockAPIClient{cc}
+}
+
+func (c *blockAPIClient) Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, BlockAPI_Block_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockAPIClient) BlockTransaction(ctx context.Context, in *BlockTransactionRequest, opts ...grpc.CallOption) (*BlockTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockTransactionResponse)
+	err := c.cc.Invoke(ctx, BlockAPI_BlockTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlockAPIServer is the server API for BlockAPI service.
+// All implementations must embed UnimplementedBlockAPIServer
+// for forward compatibility.
+//
+// BlockAPI is generated from server.BlockAPIServicer.
+type BlockAPIServer interface {
+	// Block serves /block.
+	Block(context.Context, *BlockRequest) (*BlockResponse, error)
+	// BlockTransaction serves /block/transaction.
+	BlockTransaction(context.Context, *BlockTransactionRequest) (*BlockTransactionResponse, error)
+	mustEmbedUnimplementedBlockAPIServer()
+}
+
+// UnimplementedBlockAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBlockAPIServer struct{}
+
+func (UnimplementedBlockAPIServer) Block(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (UnimplementedBlockAPIServer) BlockTransaction(context.Context, *BlockTransactionRequest) (*BlockTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockTransaction not implemented")
+}
+func (UnimplementedBlockAPIServer) mustEmbedUnimplementedBlockAPIServer() {}
+func (UnimplementedBlockAPIServer) testEmbeddedByValue()                  {}
+
+// UnsafeBlockAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BlockAPIServer will
+// result in compilation errors.
+type UnsafeBlockAPIServer interface {
+	mustEmbedUnimplementedBlockAPIServer()
+}
+
+func RegisterBlockAPIServer(s grpc.ServiceRegistrar, srv BlockAPIServer) {
+	// If the following call pancis, it indicates UnimplementedBlockAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BlockAPI_ServiceDesc, srv)
+}
+
+func _BlockAPI_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockAPIServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockAPI_Block_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockAPIServer).Block(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockAPI_BlockTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockAPIServer).BlockTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockAPI_BlockTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockAPIServer).BlockTransaction(ctx, req.(*BlockTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BlockAPI_ServiceDesc is the grpc.ServiceDesc for BlockAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BlockAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mesh.BlockAPI",
+	HandlerType: (*BlockAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Block",
+			Handler:    _BlockAPI_Block_Handler,
+		},
+		{
+			MethodName: "BlockTransaction",
+			Handler:    _BlockAPI_BlockTransaction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mesh.proto",
+}
+
+const (
+	CallAPI_Call_FullMethodName = "/mesh.CallAPI/Call"
+)
+
+// CallAPIClient is the client API for CallAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CallAPI is generated from server.CallAPIServicer.
+type CallAPIClient interface {
+	// Call serves /call.
+	Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error)
+}
+
+type callAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCallAPIClient(cc grpc.ClientConnInterface) CallAPIClient {
+	return &callAPIClient{cc}
+}
+
+func (c *callAPIClient) Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CallResponse)
+	err := c.cc.Invoke(ctx, CallAPI_Call_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CallAPIServer is the server API for CallAPI service.
+// All implementations must embed UnimplementedCallAPIServer
+// for forward compatibility.
+//
+// CallAPI is generated from server.CallAPIServicer.
+type CallAPIServer interface {
+	// Call serves /call.
+	Call(context.Context, *CallRequest) (*CallResponse, error)
+	mustEmbedUnimplementedCallAPIServer()
+}
+
+// UnimplementedCallAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCallAPIServer struct{}
+
+func (UnimplementedCallAPIServer) Call(context.Context, *CallRequest) (*CallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+}
+func (UnimplementedCallAPIServer) mustEmbedUnimplementedCallAPIServer() {}
+func (UnimplementedCallAPIServer) testEmbeddedByValue()                 {}
+
+// UnsafeCallAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CallAPIServer will
+// result in compilation errors.
+type UnsafeCallAPIServer interface {
+	mustEmbedUnimplementedCallAPIServer()
+}
+
+func RegisterCallAPIServer(s grpc.ServiceRegistrar, srv CallAPIServer) {
+	// If the following call pancis, it indicates UnimplementedCallAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CallAPI_ServiceDesc, srv)
+}
+
+func _CallAPI_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallAPIServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallAPI_Call_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallAPIServer).Call(ctx, req.(*CallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CallAPI_ServiceDesc is the grpc.ServiceDesc for CallAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CallAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mesh.CallAPI",
+	HandlerType: (*CallAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Call",
+			Handler:    _CallAPI_Call_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mesh.proto",
+}
+
+const (
+	ConstructionAPI_ConstructionCombine_FullMethodName    = "/mesh.ConstructionAPI/ConstructionCombine"
+	ConstructionAPI_ConstructionDerive_FullMethodName     = "/mesh.ConstructionAPI/ConstructionDerive"
+	ConstructionAPI_ConstructionHash_FullMethodName       = "/mesh.ConstructionAPI/ConstructionHash"
+	ConstructionAPI_ConstructionMetadata_FullMethodName   = "/mesh.ConstructionAPI/ConstructionMetadata"
+	ConstructionAPI_ConstructionParse_FullMethodName      = "/mesh.ConstructionAPI/ConstructionParse"
+	ConstructionAPI_ConstructionPayloads_FullMethodName   = "/mesh.ConstructionAPI/ConstructionPayloads"
+	ConstructionAPI_ConstructionPreprocess_FullMethodName = "/mesh.ConstructionAPI/ConstructionPreprocess"
+	ConstructionAPI_ConstructionSubmit_FullMethodName     = "/mesh.ConstructionAPI/ConstructionSubmit"
+)
+
+// ConstructionAPIClient is the client API for ConstructionAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ConstructionAPI is generated from server.ConstructionAPIServicer.
+type ConstructionAPIClient interface {
+	// ConstructionCombine serves /construction/combine.
+	ConstructionCombine(ctx context.Context, in *ConstructionCombineRequest, opts ...grpc.CallOption) (*ConstructionCombineResponse, error)
+	// ConstructionDerive serves /construction/derive.
+	ConstructionDerive(ctx context.Context, in *ConstructionDeriveRequest, opts ...grpc.CallOption) (*ConstructionDeriveResponse, error)
+	// ConstructionHash serves /construction/hash.
+	ConstructionHash(ctx context.Context, in *ConstructionHashRequest, opts ...grpc.CallOption) (*TransactionIdentifierResponse, error)
+	// ConstructionMetadata serves /construction/metadata.
+	ConstructionMetadata(ctx context.Context, in *ConstructionMetadataRequest, opts ...grpc.CallOption) (*ConstructionMetadataResponse, error)
+	// ConstructionParse serves /construction/parse.
+	ConstructionParse(ctx context.Context, in *ConstructionParseRequest, opts ...grpc.CallOption) (*ConstructionParseResponse, error)
+	// ConstructionPayloads serves /construction/payloads.
+	ConstructionPayloads(ctx context.Context, in *ConstructionPayloadsRequest, opts ...grpc.CallOption) (*ConstructionPayloadsResponse, error)
+	// ConstructionPreprocess serves /construction/preprocess.
+	ConstructionPreprocess(ctx context.Context, in *ConstructionPreprocessRequest, opts ...grpc.CallOption) (*ConstructionPreprocessResponse, error)
+	// ConstructionSubmit serves /construction/submit.
+	ConstructionSubmit(ctx context.Context, in *ConstructionSubmitRequest, opts ...grpc.CallOption) (*TransactionIdentifierResponse, error)
+}
+
+type constructionAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConstructionAPIClient(cc grpc.ClientConnInterface) ConstructionAPIClient {
+	return &constructionAPIClient{cc}
+}
+
+func (c *constructionAPIClient) ConstructionCombine(ctx context.Context, in *ConstructionCombineRequest, opts ...grpc.CallOption) (*ConstructionCombineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConstructionCombineResponse)
+	err := c.cc.Invoke(ctx, ConstructionAPI_ConstructionCombine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructionAPIClient) ConstructionDerive(ctx context.Context, in *ConstructionDeriveRequest, opts ...grpc.CallOption) (*ConstructionDeriveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConstructionDeriveResponse)
+	err := c.cc.Invoke(ctx, ConstructionAPI_ConstructionDerive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructionAPIClient) ConstructionHash(ctx context.Context, in *ConstructionHashRequest, opts ...grpc.CallOption) (*TransactionIdentifierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionIdentifierResponse)
+	err := c.cc.Invoke(ctx, ConstructionAPI_ConstructionHash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructionAPIClient) ConstructionMetadata(ctx context.Context, in *ConstructionMetadataRequest, opts ...grpc.CallOption) (*ConstructionMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConstructionMetadataResponse)
+	err := c.cc.Invoke(ctx, ConstructionAPI_ConstructionMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructionAPIClient) ConstructionParse(ctx context.Context, in *ConstructionParseRequest, opts ...grpc.CallOption) (*ConstructionParseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConstructionParseResponse)
+	err := c.cc.Invoke(ctx, ConstructionAPI_ConstructionParse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructionAPIClient) ConstructionPayloads(ctx context.Context, in *ConstructionPayloadsRequest, opts ...grpc.CallOption) (*ConstructionPayloadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConstructionPayloadsResponse)
+	err := c.cc.Invoke(ctx, ConstructionAPI_ConstructionPayloads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructionAPIClient) ConstructionPreprocess(ctx context.Context, in *ConstructionPreprocessRequest, opts ...grpc.CallOption) (*ConstructionPreprocessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConstructionPreprocessResponse)
+	err := c.cc.Invoke(ctx, ConstructionAPI_ConstructionPreprocess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *constructionAPIClient) ConstructionSubmit(ctx context.Context, in *ConstructionSubmitRequest, opts ...grpc.CallOption) (*TransactionIdentifierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionIdentifierResponse)
+	err := c.cc.Invoke(ctx, ConstructionAPI_ConstructionSubmit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConstructionAPIServer is the server API for ConstructionAPI service.
+// All implementations must embed UnimplementedConstructionAPIServer
+// for forward compatibility.
+//
+// ConstructionAPI is generated from server.ConstructionAPIServicer.
+type ConstructionAPIServer interface {
+	// ConstructionCombine serves /construction/combine.
+	ConstructionCombine(context.Context, *ConstructionCombineRequest) (*ConstructionCombineResponse, error)
+	// ConstructionDerive serves /construction/derive.
+	ConstructionDerive(context.Context, *ConstructionDeriveRequest) (*ConstructionDeriveResponse, error)
+	// ConstructionHash serves /construction/hash.
+	ConstructionHash(context.Context, *ConstructionHashRequest) (*TransactionIdentifierResponse, error)
+	// ConstructionMetadata serves /construction/metadata.
+	ConstructionMetadata(context.Context, *ConstructionMetadataRequest) (*ConstructionMetadataResponse, error)
+	// ConstructionParse serves /construction/parse.
+	ConstructionParse(context.Context, *ConstructionParseRequest) (*ConstructionParseResponse, error)
+	// ConstructionPayloads serves /construction/payloads.
+	ConstructionPayloads(context.Context, *ConstructionPayloadsRequest) (*ConstructionPayloadsResponse, error)
+	// ConstructionPreprocess serves /construction/preprocess.
+	ConstructionPreprocess(context.Context, *ConstructionPreprocessRequest) (*ConstructionPreprocessResponse, error)
+	// ConstructionSubmit serves /construction/submit.
+	ConstructionSubmit(context.Context, *ConstructionSubmitRequest) (*TransactionIdentifierResponse, error)
+	mustEmbedUnimplementedConstructionAPIServer()
+}
+
+// UnimplementedConstructionAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConstructionAPIServer struct{}
+
+func (UnimplementedConstructionAPIServer) ConstructionCombine(context.Context, *ConstructionCombineRequest) (*ConstructionCombineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConstructionCombine not implemented")
+}
+func (UnimplementedConstructionAPIServer) ConstructionDerive(context.Context, *ConstructionDeriveRequest) (*ConstructionDeriveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConstructionDerive not implemented")
+}
+func (UnimplementedConstructionAPIServer) ConstructionHash(context.Context, *ConstructionHashRequest) (*TransactionIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConstructionHash not implemented")
+}
+func (UnimplementedConstructionAPIServer) ConstructionMetadata(context.Context, *ConstructionMetadataRequest) (*ConstructionMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConstructionMetadata not implemented")
+}
+func (UnimplementedConstructionAPIServer) ConstructionParse(context.Context, *ConstructionParseRequest) (*ConstructionParseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConstructionParse not implemented")
+}
+func (UnimplementedConstructionAPIServer) ConstructionPayloads(context.Context, *ConstructionPayloadsRequest) (*ConstructionPayloadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConstructionPayloads not implemented")
+}
+func (UnimplementedConstructionAPIServer) ConstructionPreprocess(context.Context, *ConstructionPreprocessRequest) (*ConstructionPreprocessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConstructionPreprocess not implemented")
+}
+func (UnimplementedConstructionAPIServer) ConstructionSubmit(context.Context, *ConstructionSubmitRequest) (*TransactionIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConstructionSubmit not implemented")
+}
+func (UnimplementedConstructionAPIServer) mustEmbedUnimplementedConstructionAPIServer() {}
+func (UnimplementedConstructionAPIServer) testEmbeddedByValue()                         {}
+
+// UnsafeConstructionAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConstructionAPIServer will
+// result in compilation errors.
+type UnsafeConstructionAPIServer interface {
+	mustEmbedUnimplementedConstructionAPIServer()
+}
+
+func RegisterConstructionAPIServer(s grpc.ServiceRegistrar, srv ConstructionAPIServer) {
+	// If the following call pancis, it indicates UnimplementedConstructionAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConstructionAPI_ServiceDesc, srv)
+}
+
+func _ConstructionAPI_ConstructionCombine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConstructionCombineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructionAPIServer).ConstructionCombine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructionAPI_ConstructionCombine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructionAPIServer).ConstructionCombine(ctx, req.(*ConstructionCombineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructionAPI_ConstructionDerive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConstructionDeriveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructionAPIServer).ConstructionDerive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructionAPI_ConstructionDerive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructionAPIServer).ConstructionDerive(ctx, req.(*ConstructionDeriveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructionAPI_ConstructionHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConstructionHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructionAPIServer).ConstructionHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructionAPI_ConstructionHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructionAPIServer).ConstructionHash(ctx, req.(*ConstructionHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructionAPI_ConstructionMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConstructionMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructionAPIServer).ConstructionMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructionAPI_ConstructionMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructionAPIServer).ConstructionMetadata(ctx, req.(*ConstructionMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructionAPI_ConstructionParse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConstructionParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructionAPIServer).ConstructionParse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructionAPI_ConstructionParse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructionAPIServer).ConstructionParse(ctx, req.(*ConstructionParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructionAPI_ConstructionPayloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConstructionPayloadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructionAPIServer).ConstructionPayloads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructionAPI_ConstructionPayloads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructionAPIServer).ConstructionPayloads(ctx, req.(*ConstructionPayloadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructionAPI_ConstructionPreprocess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConstructionPreprocessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructionAPIServer).ConstructionPreprocess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructionAPI_ConstructionPreprocess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructionAPIServer).ConstructionPreprocess(ctx, req.(*ConstructionPreprocessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConstructionAPI_ConstructionSubmit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConstructionSubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConstructionAPIServer).ConstructionSubmit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConstructionAPI_ConstructionSubmit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConstructionAPIServer).ConstructionSubmit(ctx, req.(*ConstructionSubmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConstructionAPI_ServiceDesc is the grpc.ServiceDesc for ConstructionAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConstructionAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mesh.ConstructionAPI",
+	HandlerType: (*ConstructionAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConstructionCombine",
+			Handler:    _ConstructionAPI_ConstructionCombine_Handler,
+		},
+		{
+			MethodName: "ConstructionDerive",
+			Handler:    _ConstructionAPI_ConstructionDerive_Handler,
+		},
+		{
+			MethodName: "ConstructionHash",
+			Handler:    _ConstructionAPI_ConstructionHash_Handler,
+		},
+		{
+			MethodName: "ConstructionMetadata",
+			Handler:    _ConstructionAPI_ConstructionMetadata_Handler,
+		},
+		{
+			MethodName: "ConstructionParse",
+			Handler:    _ConstructionAPI_ConstructionParse_Handler,
+		},
+		{
+			MethodName: "ConstructionPayloads",
+			Handler:    _ConstructionAPI_ConstructionPayloads_Handler,
+		},
+		{
+			MethodName: "ConstructionPreprocess",
+			Handler:    _ConstructionAPI_ConstructionPreprocess_Handler,
+		},
+		{
+			MethodName: "ConstructionSubmit",
+			Handler:    _ConstructionAPI_ConstructionSubmit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mesh.proto",
+}
+
+const (
+	EventsAPI_EventsBlocks_FullMethodName = "/mesh.EventsAPI/EventsBlocks"
+)
+
+// EventsAPIClient is the client API for EventsAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// EventsAPI is generated from server.EventsAPIServicer.
+type EventsAPIClient interface {
+	// EventsBlocks serves /events/blocks.
+	EventsBlocks(ctx context.Context, in *EventsBlocksRequest, opts ...grpc.CallOption) (*EventsBlocksResponse, error)
+}
+
+type eventsAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventsAPIClient(cc grpc.ClientConnInterface) EventsAPIClient {
+	return &eventsAPIClient{cc}
+}
+
+func (c *eventsAPIClient) EventsBlocks(ctx context.Context, in *EventsBlocksRequest, opts ...grpc.CallOption) (*EventsBlocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventsBlocksResponse)
+	err := c.cc.Invoke(ctx, EventsAPI_EventsBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventsAPIServer is the server API for EventsAPI service.
+// All implementations must embed UnimplementedEventsAPIServer
+// for forward compatibility.
+//
+// EventsAPI is generated from server.EventsAPIServicer.
+type EventsAPIServer interface {
+	// EventsBlocks serves /events/blocks.
+	EventsBlocks(context.Context, *EventsBlocksRequest) (*EventsBlocksResponse, error)
+	mustEmbedUnimplementedEventsAPIServer()
+}
+
+// UnimplementedEventsAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEventsAPIServer struct{}
+
+func (UnimplementedEventsAPIServer) EventsBlocks(context.Context, *EventsBlocksRequest) (*EventsBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventsBlocks not implemented")
+}
+func (UnimplementedEventsAPIServer) mustEmbedUnimplementedEventsAPIServer() {}
+func (UnimplementedEventsAPIServer) testEmbeddedByValue()                   {}
+
+// UnsafeEventsAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventsAPIServer will
+// result in compilation errors.
+type UnsafeEventsAPIServer interface {
+	mustEmbedUnimplementedEventsAPIServer()
+}
+
+func RegisterEventsAPIServer(s grpc.ServiceRegistrar, srv EventsAPIServer) {
+	// If the following call pancis, it indicates UnimplementedEventsAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EventsAPI_ServiceDesc, srv)
+}
+
+func _EventsAPI_EventsBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventsBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsAPIServer).EventsBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventsAPI_EventsBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsAPIServer).EventsBlocks(ctx, req.(*EventsBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EventsAPI_ServiceDesc is the grpc.ServiceDesc for EventsAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventsAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mesh.EventsAPI",
+	HandlerType: (*EventsAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EventsBlocks",
+			Handler:    _EventsAPI_EventsBlocks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mesh.proto",
+}
+
+const (
+	MempoolAPI_Mempool_FullMethodName            = "/mesh.MempoolAPI/Mempool"
+	MempoolAPI_MempoolTransaction_FullMethodName = "/mesh.MempoolAPI/MempoolTransaction"
+)
+
+// MempoolAPIClient is the client API for MempoolAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MempoolAPI is generated from server.MempoolAPIServicer.
+type MempoolAPIClient interface {
+	// Mempool serves /mempool.
+	Mempool(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*MempoolResponse, error)
+	// MempoolTransaction serves /mempool/transaction.
+	MempoolTransaction(ctx context.Context, in *MempoolTransactionRequest, opts ...grpc.CallOption) (*MempoolTransactionResponse, error)
+}
+
+type mempoolAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMempoolAPIClient(cc grpc.ClientConnInterface) MempoolAPIClient {
+	return &mempoolAPIClient{cc}
+}
+
+func (c *mempoolAPIClient) Mempool(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*MempoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MempoolResponse)
+	err := c.cc.Invoke(ctx, MempoolAPI_Mempool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolAPIClient) MempoolTransaction(ctx context.Context, in *MempoolTransactionRequest, opts ...grpc.CallOption) (*MempoolTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MempoolTransactionResponse)
+	err := c.cc.Invoke(ctx, MempoolAPI_MempoolTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MempoolAPIServer is the server API for MempoolAPI service.
+// All implementations must embed UnimplementedMempoolAPIServer
+// for forward compatibility.
+//
+// MempoolAPI is generated from server.MempoolAPIServicer.
+type MempoolAPIServer interface {
+	// Mempool serves /mempool.
+	Mempool(context.Context, *NetworkRequest) (*MempoolResponse, error)
+	// MempoolTransaction serves /mempool/transaction.
+	MempoolTransaction(context.Context, *MempoolTransactionRequest) (*MempoolTransactionResponse, error)
+	mustEmbedUnimplementedMempoolAPIServer()
+}
+
+// UnimplementedMempoolAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMempoolAPIServer struct{}
+
+func (UnimplementedMempoolAPIServer) Mempool(context.Context, *NetworkRequest) (*MempoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mempool not implemented")
+}
+func (UnimplementedMempoolAPIServer) MempoolTransaction(context.Context, *MempoolTransactionRequest) (*MempoolTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MempoolTransaction not implemented")
+}
+func (UnimplementedMempoolAPIServer) mustEmbedUnimplementedMempoolAPIServer() {}
+func (UnimplementedMempoolAPIServer) testEmbeddedByValue()                    {}
+
+// UnsafeMempoolAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MempoolAPIServer will
+// result in compilation errors.
+type UnsafeMempoolAPIServer interface {
+	mustEmbedUnimplementedMempoolAPIServer()
+}
+
+func RegisterMempoolAPIServer(s grpc.ServiceRegistrar, srv MempoolAPIServer) {
+	// If the following call pancis, it indicates UnimplementedMempoolAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MempoolAPI_ServiceDesc, srv)
+}
+
+func _MempoolAPI_Mempool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolAPIServer).Mempool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MempoolAPI_Mempool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolAPIServer).Mempool(ctx, req.(*NetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MempoolAPI_MempoolTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MempoolTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolAPIServer).MempoolTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MempoolAPI_MempoolTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolAPIServer).MempoolTransaction(ctx, req.(*MempoolTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MempoolAPI_ServiceDesc is the grpc.ServiceDesc for MempoolAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MempoolAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mesh.MempoolAPI",
+	HandlerType: (*MempoolAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Mempool",
+			Handler:    _MempoolAPI_Mempool_Handler,
+		},
+		{
+			MethodName: "MempoolTransaction",
+			Handler:    _MempoolAPI_MempoolTransaction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mesh.proto",
+}
+
+const (
+	NetworkAPI_NetworkList_FullMethodName    = "/mesh.NetworkAPI/NetworkList"
+	NetworkAPI_NetworkOptions_FullMethodName = "/mesh.NetworkAPI/NetworkOptions"
+	NetworkAPI_NetworkStatus_FullMethodName  = "/mesh.NetworkAPI/NetworkStatus"
+)
+
+// NetworkAPIClient is the client API for NetworkAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// NetworkAPI is generated from server.NetworkAPIServicer.
+type NetworkAPIClient interface {
+	// NetworkList serves /network/list.
+	NetworkList(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*NetworkListResponse, error)
+	// NetworkOptions serves /network/options.
+	NetworkOptions(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkOptionsResponse, error)
+	// NetworkStatus serves /network/status.
+	NetworkStatus(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkStatusResponse, error)
+}
+
+type networkAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNetworkAPIClient(cc grpc.ClientConnInterface) NetworkAPIClient {
+	return &networkAPIClient{cc}
+}
+
+func (c *networkAPIClient) NetworkList(ctx context.Context, in *MetadataRequest, opts ...grpc.CallOption) (*NetworkListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NetworkListResponse)
+	err := c.cc.Invoke(ctx, NetworkAPI_NetworkList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkAPIClient) NetworkOptions(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NetworkOptionsResponse)
+	err := c.cc.Invoke(ctx, NetworkAPI_NetworkOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *networkAPIClient) NetworkStatus(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NetworkStatusResponse)
+	err := c.cc.Invoke(ctx, NetworkAPI_NetworkStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NetworkAPIServer is the server API for NetworkAPI service.
+// All implementations must embed UnimplementedNetworkAPIServer
+// for forward compatibility.
+//
+// NetworkAPI is generated from server.NetworkAPIServicer.
+type NetworkAPIServer interface {
+	// NetworkList serves /network/list.
+	NetworkList(context.Context, *MetadataRequest) (*NetworkListResponse, error)
+	// NetworkOptions serves /network/options.
+	NetworkOptions(context.Context, *NetworkRequest) (*NetworkOptionsResponse, error)
+	// NetworkStatus serves /network/status.
+	NetworkStatus(context.Context, *NetworkRequest) (*NetworkStatusResponse, error)
+	mustEmbedUnimplementedNetworkAPIServer()
+}
+
+// UnimplementedNetworkAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNetworkAPIServer struct{}
+
+func (UnimplementedNetworkAPIServer) NetworkList(context.Context, *MetadataRequest) (*NetworkListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkList not implemented")
+}
+func (UnimplementedNetworkAPIServer) NetworkOptions(context.Context, *NetworkRequest) (*NetworkOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkOptions not implemented")
+}
+func (UnimplementedNetworkAPIServer) NetworkStatus(context.Context, *NetworkRequest) (*NetworkStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkStatus not implemented")
+}
+func (UnimplementedNetworkAPIServer) mustEmbedUnimplementedNetworkAPIServer() {}
+func (UnimplementedNetworkAPIServer) testEmbeddedByValue()                    {}
+
+// UnsafeNetworkAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NetworkAPIServer will
+// result in compilation errors.
+type UnsafeNetworkAPIServer interface {
+	mustEmbedUnimplementedNetworkAPIServer()
+}
+
+func RegisterNetworkAPIServer(s grpc.ServiceRegistrar, srv NetworkAPIServer) {
+	// If the following call pancis, it indicates UnimplementedNetworkAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NetworkAPI_ServiceDesc, srv)
+}
+
+func _NetworkAPI_NetworkList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkAPIServer).NetworkList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkAPI_NetworkList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkAPIServer).NetworkList(ctx, req.(*MetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkAPI_NetworkOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkAPIServer).NetworkOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkAPI_NetworkOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkAPIServer).NetworkOptions(ctx, req.(*NetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NetworkAPI_NetworkStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkAPIServer).NetworkStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NetworkAPI_NetworkStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkAPIServer).NetworkStatus(ctx, req.(*NetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NetworkAPI_ServiceDesc is the grpc.ServiceDesc for NetworkAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NetworkAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mesh.NetworkAPI",
+	HandlerType: (*NetworkAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NetworkList",
+			Handler:    _NetworkAPI_NetworkList_Handler,
+		},
+		{
+			MethodName: "NetworkOptions",
+			Handler:    _NetworkAPI_NetworkOptions_Handler,
+		},
+		{
+			MethodName: "NetworkStatus",
+			Handler:    _NetworkAPI_NetworkStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mesh.proto",
+}
+
+const (
+	SearchAPI_SearchTransactions_FullMethodName = "/mesh.SearchAPI/SearchTransactions"
+)
+
+// SearchAPIClient is the client API for SearchAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SearchAPI is generated from server.SearchAPIServicer.
+type SearchAPIClient interface {
+	// SearchTransactions serves /search/transactions.
+	SearchTransactions(ctx context.Context, in *SearchTransactionsRequest, opts ...grpc.CallOption) (*SearchTransactionsResponse, error)
+}
+
+type searchAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSearchAPIClient(cc grpc.ClientConnInterface) SearchAPIClient {
+	return &searchAPIClient{cc}
+}
+
+func (c *searchAPIClient) SearchTransactions(ctx context.Context, in *SearchTransactionsRequest, opts ...grpc.CallOption) (*SearchTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchTransactionsResponse)
+	err := c.cc.Invoke(ctx, SearchAPI_SearchTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchAPIServer is the server API for SearchAPI service.
+// All implementations must embed UnimplementedSearchAPIServer
+// for forward compatibility.
+//
+// SearchAPI is generated from server.SearchAPIServicer.
+type SearchAPIServer interface {
+	// SearchTransactions serves /search/transactions.
+	SearchTransactions(context.Context, *SearchTransactionsRequest) (*SearchTransactionsResponse, error)
+	mustEmbedUnimplementedSearchAPIServer()
+}
+
+// UnimplementedSearchAPIServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSearchAPIServer struct{}
+
+func (UnimplementedSearchAPIServer) SearchTransactions(context.Context, *SearchTransactionsRequest) (*SearchTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTransactions not implemented")
+}
+func (UnimplementedSearchAPIServer) mustEmbedUnimplementedSearchAPIServer() {}
+func (UnimplementedSearchAPIServer) testEmbeddedByValue()                   {}
+
+// UnsafeSearchAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SearchAPIServer will
+// result in compilation errors.
+type UnsafeSearchAPIServer interface {
+	mustEmbedUnimplementedSearchAPIServer()
+}
+
+func RegisterSearchAPIServer(s grpc.ServiceRegistrar, srv SearchAPIServer) {
+	// If the following call pancis, it indicates UnimplementedSearchAPIServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SearchAPI_ServiceDesc, srv)
+}
+
+func _SearchAPI_SearchTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchAPIServer).SearchTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchAPI_SearchTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchAPIServer).SearchTransactions(ctx, req.(*SearchTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SearchAPI_ServiceDesc is the grpc.ServiceDesc for SearchAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SearchAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mesh.SearchAPI",
+	HandlerType: (*SearchAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchTransactions",
+			Handler:    _SearchAPI_SearchTransactions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mesh.proto",
+}
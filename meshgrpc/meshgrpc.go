@@ -0,0 +1,145 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package meshgrpc serves and invokes the Mesh API over gRPC.
+//
+// The protobuf definitions in mesh.proto (and the conversions
+// between the generated messages and the types package) are
+// generated from the types and server packages by meshgrpc/gen.
+// A server.*APIServicer can be served with the Register*Servicer
+// functions, and a *Client can be used directly or as the transport
+// of a client.APIClient (see NewTransport).
+//
+// Only the *Client avoids JSON: it converts between the types
+// package and protobuf messages directly (and can be provided to a
+// fetcher.Fetcher with fetcher.WithMeshClient). The *Transport exists
+// so that code written against a client.APIClient can reach a gRPC
+// server without changes, but each request and response is still
+// encoded as JSON on the client side (in addition to the protobuf
+// encoding), so it is not faster than HTTP.
+package meshgrpc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/dominant-strategies/mesh-sdk-go/client"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// metadataToProto converts a metadata map to a *structpb.Struct.
+// Values that are not JSON primitives are converted with a JSON
+// round trip (like they would be in an HTTP request).
+func metadataToProto(metadata map[string]interface{}) (*structpb.Struct, error) {
+	if metadata == nil {
+		return nil, nil
+	}
+
+	s, err := structpb.NewStruct(metadata)
+	if err == nil {
+		return s, nil
+	}
+
+	raw, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal metadata: %w", err)
+	}
+
+	s = &structpb.Struct{}
+	if err := s.UnmarshalJSON(raw); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal metadata: %w", err)
+	}
+
+	return s, nil
+}
+
+// metadataFromProto converts a *structpb.Struct
+// to a metadata map.
+func metadataFromProto(s *structpb.Struct) (map[string]interface{}, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	return s.AsMap(), nil
+}
+
+// errorStatus converts a *types.Error returned by a servicer to
+// a gRPC status error. The *types.Error is included in the status
+// details so that it can be recovered by the *Client.
+func errorStatus(rosettaErr *types.Error) error {
+	code := codes.Internal
+	if rosettaErr.Retriable {
+		code = codes.Unavailable
+	}
+
+	return withDetails(status.New(code, rosettaErr.Message), rosettaErr)
+}
+
+// invalidRequestStatus converts a request validation error to
+// a gRPC status error. Like the HTTP controllers, the error is
+// returned as a *types.Error with the error message.
+func invalidRequestStatus(err error) error {
+	return withDetails(status.New(codes.InvalidArgument, err.Error()), &types.Error{
+		Message: err.Error(),
+	})
+}
+
+func withDetails(s *status.Status, rosettaErr *types.Error) error {
+	details, err := errorToProto(rosettaErr)
+	if err != nil {
+		return s.Err()
+	}
+
+	withDetails, err := s.WithDetails(details)
+	if err != nil {
+		return s.Err()
+	}
+
+	return withDetails.Err()
+}
+
+// statusError extracts the *types.Error from a gRPC status error
+// (if present). Like client.APIClient, the returned error describes
+// the *types.Error (or wraps client.ErrRetriable if the request
+// failed with a transient status code).
+func statusError(err error) (*types.Error, error) {
+	s, ok := status.FromError(err)
+	if !ok {
+		return nil, err
+	}
+
+	for _, detail := range s.Details() {
+		details, ok := detail.(*Error)
+		if !ok {
+			continue
+		}
+
+		rosettaErr, convertErr := errorFromProto(details)
+		if convertErr != nil {
+			return nil, err
+		}
+
+		return rosettaErr, fmt.Errorf("error %+v", rosettaErr)
+	}
+
+	if retriableCode(s.Code()) {
+		return nil, fmt.Errorf("%w: %w", err, client.ErrRetriable)
+	}
+
+	return nil, err
+}
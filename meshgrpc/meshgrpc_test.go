@@ -0,0 +1,413 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meshgrpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	"github.com/dominant-strategies/mesh-sdk-go/client"
+	"github.com/dominant-strategies/mesh-sdk-go/fetcher"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+var (
+	network = &types.NetworkIdentifier{
+		Blockchain: "blockchain",
+		Network:    "network",
+		SubNetworkIdentifier: &types.SubNetworkIdentifier{
+			Network: "shard",
+			Metadata: map[string]interface{}{
+				"zone": "a",
+			},
+		},
+	}
+
+	networkStatus = &types.NetworkStatusResponse{
+		CurrentBlockIdentifier: &types.BlockIdentifier{
+			Index: 10,
+			Hash:  "block 10",
+		},
+		CurrentBlockTimestamp: 1582833600000,
+		GenesisBlockIdentifier: &types.BlockIdentifier{
+			Index: 0,
+			Hash:  "block 0",
+		},
+		SyncStatus: &types.SyncStatus{
+			CurrentIndex: types.Int64(10),
+			Synced:       types.Bool(true),
+		},
+		Peers: []*types.Peer{
+			{
+				PeerID: "peer",
+			},
+		},
+	}
+
+	currency = &types.Currency{
+		Symbol:   "BTC",
+		Decimals: 8,
+	}
+
+	block = &types.Block{
+		BlockIdentifier: networkStatus.CurrentBlockIdentifier,
+		ParentBlockIdentifier: &types.BlockIdentifier{
+			Index: 9,
+			Hash:  "block 9",
+		},
+		Timestamp: 1582833600000,
+		Transactions: []*types.Transaction{
+			{
+				TransactionIdentifier: &types.TransactionIdentifier{
+					Hash: "tx",
+				},
+				Operations: []*types.Operation{
+					{
+						OperationIdentifier: &types.OperationIdentifier{
+							Index:        0,
+							NetworkIndex: types.Int64(1),
+						},
+						Type:   "transfer",
+						Status: types.String("success"),
+						Account: &types.AccountIdentifier{
+							Address: "addr",
+						},
+						Amount: &types.Amount{
+							Value:    "-100",
+							Currency: currency,
+						},
+						CoinChange: &types.CoinChange{
+							CoinIdentifier: &types.CoinIdentifier{
+								Identifier: "coin",
+							},
+							CoinAction: types.CoinSpent,
+						},
+						Metadata: map[string]interface{}{
+							"list":   []interface{}{"a", float64(1)},
+							"nested": map[string]interface{}{"ok": true},
+						},
+					},
+				},
+				RelatedTransactions: []*types.RelatedTransaction{
+					{
+						TransactionIdentifier: &types.TransactionIdentifier{
+							Hash: "other",
+						},
+						Direction: types.Forward,
+					},
+				},
+			},
+		},
+	}
+)
+
+type networkServicer struct {
+	err *types.Error
+}
+
+func (s *networkServicer) NetworkList(
+	ctx context.Context,
+	request *types.MetadataRequest,
+) (*types.NetworkListResponse, *types.Error) {
+	return &types.NetworkListResponse{
+		NetworkIdentifiers: []*types.NetworkIdentifier{network},
+	}, nil
+}
+
+func (s *networkServicer) NetworkOptions(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.NetworkOptionsResponse, *types.Error) {
+	return nil, s.err
+}
+
+func (s *networkServicer) NetworkStatus(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.NetworkStatusResponse, *types.Error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return networkStatus, nil
+}
+
+type blockServicer struct{}
+
+func (s *blockServicer) Block(
+	ctx context.Context,
+	request *types.BlockRequest,
+) (*types.BlockResponse, *types.Error) {
+	return &types.BlockResponse{
+		Block: block,
+	}, nil
+}
+
+func (s *blockServicer) BlockTransaction(
+	ctx context.Context,
+	request *types.BlockTransactionRequest,
+) (*types.BlockTransactionResponse, *types.Error) {
+	return nil, &types.Error{Code: 1, Message: "not implemented"}
+}
+
+// newTestConn serves the servicers over an in-memory
+// listener and returns a connection to it.
+func newTestConn(t *testing.T, servicerErr *types.Error) *grpc.ClientConn {
+	a, err := asserter.NewServer(
+		[]string{"transfer"},
+		false,
+		[]*types.NetworkIdentifier{network},
+		nil,
+		false,
+		"",
+	)
+	assert.NoError(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	RegisterNetworkAPIServicer(s, &networkServicer{err: servicerErr}, a)
+	RegisterBlockAPIServicer(s, &blockServicer{}, a)
+	go func() {
+		_ = s.Serve(listener)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+func TestConversions(t *testing.T) {
+	converted, err := blockToProto(block)
+	assert.NoError(t, err)
+
+	roundTrip, err := blockFromProto(converted)
+	assert.NoError(t, err)
+	assert.Equal(t, block, roundTrip)
+
+	// Metadata that is not made of JSON primitives
+	// is converted with a JSON round trip.
+	metadata, err := metadataToProto(map[string]interface{}{
+		"strings": []string{"a", "b"},
+	})
+	assert.NoError(t, err)
+
+	converted2, err := metadataFromProto(metadata)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"strings": []interface{}{"a", "b"},
+	}, converted2)
+}
+
+func TestClient(t *testing.T) {
+	var tests = map[string]struct {
+		request     *types.NetworkRequest
+		servicerErr *types.Error
+
+		expectedStatus *types.NetworkStatusResponse
+		expectedErr    *types.Error
+	}{
+		"success": {
+			request:        &types.NetworkRequest{NetworkIdentifier: network},
+			expectedStatus: networkStatus,
+		},
+		"servicer error": {
+			request: &types.NetworkRequest{NetworkIdentifier: network},
+			servicerErr: &types.Error{
+				Code:      12,
+				Message:   "node behind",
+				Retriable: true,
+				Details:   map[string]interface{}{"tip": float64(9)},
+			},
+			expectedErr: &types.Error{
+				Code:      12,
+				Message:   "node behind",
+				Retriable: true,
+				Details:   map[string]interface{}{"tip": float64(9)},
+			},
+		},
+		"invalid request": {
+			request: &types.NetworkRequest{
+				NetworkIdentifier: &types.NetworkIdentifier{
+					Blockchain: "other",
+					Network:    "network",
+				},
+			},
+			expectedErr: &types.Error{
+				Message: "network identifier {\"blockchain\":\"other\",\"network\":\"network\"} is not supported: requestNetwork is not supported",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient(newTestConn(t, test.servicerErr))
+
+			status, clientErr, err := c.NetworkStatus(context.Background(), test.request)
+			assert.Equal(t, test.expectedStatus, status)
+			assert.Equal(t, test.expectedErr, clientErr)
+			assert.Equal(t, test.expectedErr != nil, err != nil)
+		})
+	}
+}
+
+var _ fetcher.MeshClient = (*Client)(nil)
+
+func TestClientUnavailable(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+	assert.NoError(t, listener.Close())
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	// Like client.APIClient, transient failures
+	// are returned as client.ErrRetriable.
+	status, clientErr, err := NewClient(conn).NetworkStatus(
+		context.Background(),
+		&types.NetworkRequest{NetworkIdentifier: network},
+	)
+	assert.Nil(t, status)
+	assert.Nil(t, clientErr)
+	assert.True(t, errors.Is(err, client.ErrRetriable))
+}
+
+func TestFetcherWithClient(t *testing.T) {
+	ctx := context.Background()
+
+	a, err := asserter.NewClientWithOptions(
+		network,
+		networkStatus.GenesisBlockIdentifier,
+		[]string{"transfer"},
+		[]*types.OperationStatus{{Status: "success", Successful: true}},
+		nil,
+		nil,
+		&asserter.Validations{Enabled: false},
+	)
+	assert.NoError(t, err)
+
+	// No HTTP requests are made, so the server
+	// address is never used.
+	f := fetcher.New(
+		"http://localhost:0",
+		fetcher.WithMeshClient(NewClient(newTestConn(t, nil))),
+		fetcher.WithAsserter(a),
+		fetcher.WithRetryElapsedTime(time.Second),
+		fetcher.WithMaxRetries(1),
+	)
+
+	status, fetchErr := f.NetworkStatusRetry(ctx, network, nil)
+	assert.Nil(t, fetchErr)
+	assert.Equal(t, networkStatus, status)
+
+	fetchedBlock, fetchErr := f.BlockRetry(
+		ctx,
+		network,
+		types.ConstructPartialBlockIdentifier(block.BlockIdentifier),
+	)
+	assert.Nil(t, fetchErr)
+	assert.Equal(t, block, fetchedBlock)
+
+	servicerErr := &types.Error{Code: 1, Message: "not implemented"}
+	f = fetcher.New(
+		"http://localhost:0",
+		fetcher.WithMeshClient(NewClient(newTestConn(t, servicerErr))),
+		fetcher.WithAsserter(a),
+		fetcher.WithRetryElapsedTime(time.Second),
+		fetcher.WithMaxRetries(1),
+	)
+
+	status, fetchErr = f.NetworkStatusRetry(ctx, network, nil)
+	assert.Nil(t, status)
+	assert.True(t, errors.Is(fetchErr.Err, fetcher.ErrRequestFailed))
+	assert.Equal(t, servicerErr, fetchErr.ClientErr)
+}
+
+func TestFetcherWithTransport(t *testing.T) {
+	ctx := context.Background()
+	conn := newTestConn(t, nil)
+
+	a, err := asserter.NewClientWithOptions(
+		network,
+		networkStatus.GenesisBlockIdentifier,
+		[]string{"transfer"},
+		[]*types.OperationStatus{{Status: "success", Successful: true}},
+		nil,
+		nil,
+		&asserter.Validations{Enabled: false},
+	)
+	assert.NoError(t, err)
+
+	f := fetcher.New(
+		"",
+		fetcher.WithClient(NewAPIClient(conn)),
+		fetcher.WithAsserter(a),
+		fetcher.WithRetryElapsedTime(time.Second),
+		fetcher.WithMaxRetries(1),
+	)
+
+	status, fetchErr := f.NetworkStatusRetry(ctx, network, nil)
+	assert.Nil(t, fetchErr)
+	assert.Equal(t, networkStatus, status)
+
+	fetchedBlock, fetchErr := f.BlockRetry(
+		ctx,
+		network,
+		types.ConstructPartialBlockIdentifier(block.BlockIdentifier),
+	)
+	assert.Nil(t, fetchErr)
+	assert.Equal(t, block, fetchedBlock)
+
+	// Errors returned by the servicer are surfaced
+	// like errors returned over HTTP.
+	servicerErr := &types.Error{Code: 1, Message: "not implemented"}
+	f = fetcher.New(
+		"",
+		fetcher.WithClient(NewAPIClient(newTestConn(t, servicerErr))),
+		fetcher.WithAsserter(a),
+		fetcher.WithRetryElapsedTime(time.Second),
+		fetcher.WithMaxRetries(1),
+	)
+
+	status, fetchErr = f.NetworkStatusRetry(ctx, network, nil)
+	assert.Nil(t, status)
+	assert.True(t, errors.Is(fetchErr.Err, fetcher.ErrRequestFailed))
+	assert.Equal(t, servicerErr, fetchErr.ClientErr)
+}
@@ -0,0 +1,684 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by meshgrpc/gen. DO NOT EDIT.
+
+package meshgrpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	"github.com/dominant-strategies/mesh-sdk-go/server"
+)
+
+// accountAPIServer implements AccountAPIServer using a server.AccountAPIServicer.
+type accountAPIServer struct {
+	UnimplementedAccountAPIServer
+
+	servicer server.AccountAPIServicer
+	asserter *asserter.Asserter
+}
+
+// RegisterAccountAPIServicer registers a server.AccountAPIServicer with a gRPC
+// server. Requests are validated with the *asserter.Asserter before
+// they are passed to the servicer (like the HTTP controllers).
+func RegisterAccountAPIServicer(
+	registrar grpc.ServiceRegistrar,
+	servicer server.AccountAPIServicer,
+	asserter *asserter.Asserter,
+) {
+	RegisterAccountAPIServer(registrar, &accountAPIServer{
+		servicer: servicer,
+		asserter: asserter,
+	})
+}
+
+// AccountBalance serves /account/balance.
+func (s *accountAPIServer) AccountBalance(ctx context.Context, req *AccountBalanceRequest) (*AccountBalanceResponse, error) {
+	request, err := accountBalanceRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.AccountBalanceRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.AccountBalance(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := accountBalanceResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// AccountCoins serves /account/coins.
+func (s *accountAPIServer) AccountCoins(ctx context.Context, req *AccountCoinsRequest) (*AccountCoinsResponse, error) {
+	request, err := accountCoinsRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.AccountCoinsRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.AccountCoins(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := accountCoinsResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// blockAPIServer implements BlockAPIServer using a server.BlockAPIServicer.
+type blockAPIServer struct {
+	UnimplementedBlockAPIServer
+
+	servicer server.BlockAPIServicer
+	asserter *asserter.Asserter
+}
+
+// RegisterBlockAPIServicer registers a server.BlockAPIServicer with a gRPC
+// server. Requests are validated with the *asserter.Asserter before
+// they are passed to the servicer (like the HTTP controllers).
+func RegisterBlockAPIServicer(
+	registrar grpc.ServiceRegistrar,
+	servicer server.BlockAPIServicer,
+	asserter *asserter.Asserter,
+) {
+	RegisterBlockAPIServer(registrar, &blockAPIServer{
+		servicer: servicer,
+		asserter: asserter,
+	})
+}
+
+// Block serves /block.
+func (s *blockAPIServer) Block(ctx context.Context, req *BlockRequest) (*BlockResponse, error) {
+	request, err := blockRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.BlockRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.Block(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := blockResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// BlockTransaction serves /block/transaction.
+func (s *blockAPIServer) BlockTransaction(ctx context.Context, req *BlockTransactionRequest) (*BlockTransactionResponse, error) {
+	request, err := blockTransactionRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.BlockTransactionRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.BlockTransaction(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := blockTransactionResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// callAPIServer implements CallAPIServer using a server.CallAPIServicer.
+type callAPIServer struct {
+	UnimplementedCallAPIServer
+
+	servicer server.CallAPIServicer
+	asserter *asserter.Asserter
+}
+
+// RegisterCallAPIServicer registers a server.CallAPIServicer with a gRPC
+// server. Requests are validated with the *asserter.Asserter before
+// they are passed to the servicer (like the HTTP controllers).
+func RegisterCallAPIServicer(
+	registrar grpc.ServiceRegistrar,
+	servicer server.CallAPIServicer,
+	asserter *asserter.Asserter,
+) {
+	RegisterCallAPIServer(registrar, &callAPIServer{
+		servicer: servicer,
+		asserter: asserter,
+	})
+}
+
+// Call serves /call.
+func (s *callAPIServer) Call(ctx context.Context, req *CallRequest) (*CallResponse, error) {
+	request, err := callRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.CallRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.Call(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := callResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// constructionAPIServer implements ConstructionAPIServer using a server.ConstructionAPIServicer.
+type constructionAPIServer struct {
+	UnimplementedConstructionAPIServer
+
+	servicer server.ConstructionAPIServicer
+	asserter *asserter.Asserter
+}
+
+// RegisterConstructionAPIServicer registers a server.ConstructionAPIServicer with a gRPC
+// server. Requests are validated with the *asserter.Asserter before
+// they are passed to the servicer (like the HTTP controllers).
+func RegisterConstructionAPIServicer(
+	registrar grpc.ServiceRegistrar,
+	servicer server.ConstructionAPIServicer,
+	asserter *asserter.Asserter,
+) {
+	RegisterConstructionAPIServer(registrar, &constructionAPIServer{
+		servicer: servicer,
+		asserter: asserter,
+	})
+}
+
+// ConstructionCombine serves /construction/combine.
+func (s *constructionAPIServer) ConstructionCombine(ctx context.Context, req *ConstructionCombineRequest) (*ConstructionCombineResponse, error) {
+	request, err := constructionCombineRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.ConstructionCombineRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.ConstructionCombine(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := constructionCombineResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// ConstructionDerive serves /construction/derive.
+func (s *constructionAPIServer) ConstructionDerive(ctx context.Context, req *ConstructionDeriveRequest) (*ConstructionDeriveResponse, error) {
+	request, err := constructionDeriveRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.ConstructionDeriveRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.ConstructionDerive(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := constructionDeriveResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// ConstructionHash serves /construction/hash.
+func (s *constructionAPIServer) ConstructionHash(ctx context.Context, req *ConstructionHashRequest) (*TransactionIdentifierResponse, error) {
+	request, err := constructionHashRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.ConstructionHashRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.ConstructionHash(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := transactionIdentifierResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// ConstructionMetadata serves /construction/metadata.
+func (s *constructionAPIServer) ConstructionMetadata(ctx context.Context, req *ConstructionMetadataRequest) (*ConstructionMetadataResponse, error) {
+	request, err := constructionMetadataRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.ConstructionMetadataRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.ConstructionMetadata(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := constructionMetadataResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// ConstructionParse serves /construction/parse.
+func (s *constructionAPIServer) ConstructionParse(ctx context.Context, req *ConstructionParseRequest) (*ConstructionParseResponse, error) {
+	request, err := constructionParseRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.ConstructionParseRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.ConstructionParse(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := constructionParseResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// ConstructionPayloads serves /construction/payloads.
+func (s *constructionAPIServer) ConstructionPayloads(ctx context.Context, req *ConstructionPayloadsRequest) (*ConstructionPayloadsResponse, error) {
+	request, err := constructionPayloadsRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.ConstructionPayloadsRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.ConstructionPayloads(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := constructionPayloadsResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// ConstructionPreprocess serves /construction/preprocess.
+func (s *constructionAPIServer) ConstructionPreprocess(ctx context.Context, req *ConstructionPreprocessRequest) (*ConstructionPreprocessResponse, error) {
+	request, err := constructionPreprocessRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.ConstructionPreprocessRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.ConstructionPreprocess(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := constructionPreprocessResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// ConstructionSubmit serves /construction/submit.
+func (s *constructionAPIServer) ConstructionSubmit(ctx context.Context, req *ConstructionSubmitRequest) (*TransactionIdentifierResponse, error) {
+	request, err := constructionSubmitRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.ConstructionSubmitRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.ConstructionSubmit(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := transactionIdentifierResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// eventsAPIServer implements EventsAPIServer using a server.EventsAPIServicer.
+type eventsAPIServer struct {
+	UnimplementedEventsAPIServer
+
+	servicer server.EventsAPIServicer
+	asserter *asserter.Asserter
+}
+
+// RegisterEventsAPIServicer registers a server.EventsAPIServicer with a gRPC
+// server. Requests are validated with the *asserter.Asserter before
+// they are passed to the servicer (like the HTTP controllers).
+func RegisterEventsAPIServicer(
+	registrar grpc.ServiceRegistrar,
+	servicer server.EventsAPIServicer,
+	asserter *asserter.Asserter,
+) {
+	RegisterEventsAPIServer(registrar, &eventsAPIServer{
+		servicer: servicer,
+		asserter: asserter,
+	})
+}
+
+// EventsBlocks serves /events/blocks.
+func (s *eventsAPIServer) EventsBlocks(ctx context.Context, req *EventsBlocksRequest) (*EventsBlocksResponse, error) {
+	request, err := eventsBlocksRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.EventsBlocksRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.EventsBlocks(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := eventsBlocksResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// mempoolAPIServer implements MempoolAPIServer using a server.MempoolAPIServicer.
+type mempoolAPIServer struct {
+	UnimplementedMempoolAPIServer
+
+	servicer server.MempoolAPIServicer
+	asserter *asserter.Asserter
+}
+
+// RegisterMempoolAPIServicer registers a server.MempoolAPIServicer with a gRPC
+// server. Requests are validated with the *asserter.Asserter before
+// they are passed to the servicer (like the HTTP controllers).
+func RegisterMempoolAPIServicer(
+	registrar grpc.ServiceRegistrar,
+	servicer server.MempoolAPIServicer,
+	asserter *asserter.Asserter,
+) {
+	RegisterMempoolAPIServer(registrar, &mempoolAPIServer{
+		servicer: servicer,
+		asserter: asserter,
+	})
+}
+
+// Mempool serves /mempool.
+func (s *mempoolAPIServer) Mempool(ctx context.Context, req *NetworkRequest) (*MempoolResponse, error) {
+	request, err := networkRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.NetworkRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.Mempool(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := mempoolResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// MempoolTransaction serves /mempool/transaction.
+func (s *mempoolAPIServer) MempoolTransaction(ctx context.Context, req *MempoolTransactionRequest) (*MempoolTransactionResponse, error) {
+	request, err := mempoolTransactionRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.MempoolTransactionRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.MempoolTransaction(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := mempoolTransactionResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// networkAPIServer implements NetworkAPIServer using a server.NetworkAPIServicer.
+type networkAPIServer struct {
+	UnimplementedNetworkAPIServer
+
+	servicer server.NetworkAPIServicer
+	asserter *asserter.Asserter
+}
+
+// RegisterNetworkAPIServicer registers a server.NetworkAPIServicer with a gRPC
+// server. Requests are validated with the *asserter.Asserter before
+// they are passed to the servicer (like the HTTP controllers).
+func RegisterNetworkAPIServicer(
+	registrar grpc.ServiceRegistrar,
+	servicer server.NetworkAPIServicer,
+	asserter *asserter.Asserter,
+) {
+	RegisterNetworkAPIServer(registrar, &networkAPIServer{
+		servicer: servicer,
+		asserter: asserter,
+	})
+}
+
+// NetworkList serves /network/list.
+func (s *networkAPIServer) NetworkList(ctx context.Context, req *MetadataRequest) (*NetworkListResponse, error) {
+	request, err := metadataRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.MetadataRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.NetworkList(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := networkListResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// NetworkOptions serves /network/options.
+func (s *networkAPIServer) NetworkOptions(ctx context.Context, req *NetworkRequest) (*NetworkOptionsResponse, error) {
+	request, err := networkRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.NetworkRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.NetworkOptions(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := networkOptionsResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// NetworkStatus serves /network/status.
+func (s *networkAPIServer) NetworkStatus(ctx context.Context, req *NetworkRequest) (*NetworkStatusResponse, error) {
+	request, err := networkRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.NetworkRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.NetworkStatus(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := networkStatusResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
+
+// searchAPIServer implements SearchAPIServer using a server.SearchAPIServicer.
+type searchAPIServer struct {
+	UnimplementedSearchAPIServer
+
+	servicer server.SearchAPIServicer
+	asserter *asserter.Asserter
+}
+
+// RegisterSearchAPIServicer registers a server.SearchAPIServicer with a gRPC
+// server. Requests are validated with the *asserter.Asserter before
+// they are passed to the servicer (like the HTTP controllers).
+func RegisterSearchAPIServicer(
+	registrar grpc.ServiceRegistrar,
+	servicer server.SearchAPIServicer,
+	asserter *asserter.Asserter,
+) {
+	RegisterSearchAPIServer(registrar, &searchAPIServer{
+		servicer: servicer,
+		asserter: asserter,
+	})
+}
+
+// SearchTransactions serves /search/transactions.
+func (s *searchAPIServer) SearchTransactions(ctx context.Context, req *SearchTransactionsRequest) (*SearchTransactionsResponse, error) {
+	request, err := searchTransactionsRequestFromProto(req)
+	if err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	if err := s.asserter.SearchTransactionsRequest(request); err != nil {
+		return nil, invalidRequestStatus(err)
+	}
+
+	result, serviceErr := s.servicer.SearchTransactions(ctx, request)
+	if serviceErr != nil {
+		return nil, errorStatus(serviceErr)
+	}
+
+	response, err := searchTransactionsResponseToProto(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return response, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meshgrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dominant-strategies/mesh-sdk-go/client"
+)
+
+const (
+	// transportAddress is the server address used by the
+	// client.APIClient returned by NewAPIClient. Requests
+	// are never sent to it.
+	transportAddress = "http://meshgrpc"
+
+	// transportUserAgent is the user agent used by the
+	// client.APIClient returned by NewAPIClient.
+	transportUserAgent = "mesh-sdk-go/meshgrpc"
+)
+
+// Transport is an http.RoundTripper that serves Mesh HTTP
+// requests with a *Client. This allows a client.APIClient (and
+// therefore a fetcher.Fetcher) to communicate with a Mesh server
+// over gRPC.
+//
+// The Transport is a compatibility layer, not an optimization:
+// requests and responses are still encoded as JSON between the
+// client.APIClient and the Transport (and converted to and from
+// protobuf messages by the *Client). Callers that want to avoid
+// JSON overhead should use the *Client directly (a fetcher.Fetcher
+// can use it with fetcher.WithMeshClient).
+type Transport struct {
+	client *Client
+}
+
+// NewTransport returns a new *Transport.
+func NewTransport(c *Client) *Transport {
+	return &Transport{
+		client: c,
+	}
+}
+
+// NewAPIClient returns a client.APIClient that makes all
+// requests over a gRPC connection. It can be provided to
+// a fetcher.Fetcher using fetcher.WithClient.
+func NewAPIClient(conn grpc.ClientConnInterface) *client.APIClient {
+	return client.NewAPIClient(client.NewConfiguration(
+		transportAddress,
+		transportUserAgent,
+		&http.Client{
+			Transport: NewTransport(NewClient(conn)),
+		},
+	))
+}
+
+// retriableCode returns a boolean indicating if a gRPC
+// status code should be treated like a 503 (which the
+// client.APIClient returns as client.ErrRetriable).
+func retriableCode(code codes.Code) bool {
+	switch code {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+func jsonResponse(req *http.Request, statusCode int, v interface{}) (*http.Response, error) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal response: %w", err)
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json; charset=UTF-8")
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	route, ok := routes[req.URL.Path]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", req.URL.Path, ErrUnknownPath)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read request body: %w", err)
+		}
+	}
+
+	response, clientErr, err := route(req.Context(), t.client, body)
+	if clientErr != nil {
+		return jsonResponse(req, http.StatusInternalServerError, clientErr)
+	}
+
+	if err != nil {
+		if retriableCode(status.Code(err)) {
+			return jsonResponse(req, http.StatusServiceUnavailable, nil)
+		}
+
+		return nil, err
+	}
+
+	return jsonResponse(req, http.StatusOK, response)
+}
@@ -17,6 +17,7 @@ package parser
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/dominant-strategies/mesh-sdk-go/types"
 )
@@ -150,3 +151,314 @@ func (p *Parser) BalanceChanges(
 
 	return allChanges, nil
 }
+
+// TransactionBalanceChange is a *BalanceChange caused by
+// a single *types.Transaction. OperationIdentifiers contains
+// all operations in the transaction that contributed to the
+// change.
+type TransactionBalanceChange struct {
+	*BalanceChange
+
+	TransactionIdentifier *types.TransactionIdentifier `json:"transaction_identifier"`
+	OperationIdentifiers  []*types.OperationIdentifier `json:"operation_identifiers"`
+}
+
+// BalanceFlow is the netted amount of a *types.Currency
+// transferred from one *types.AccountIdentifier to another
+// in a block. Amount is always positive.
+type BalanceFlow struct {
+	From     *types.AccountIdentifier `json:"from"`
+	To       *types.AccountIdentifier `json:"to"`
+	Currency *types.Currency          `json:"currency"`
+	Amount   string                   `json:"amount"`
+
+	// TransactionIdentifiers contains all transactions
+	// that moved funds between From and To (in either
+	// direction).
+	TransactionIdentifiers []*types.TransactionIdentifier `json:"transaction_identifiers"`
+}
+
+// BlockBalanceChanges contains all balance changes in
+// a block attributed to the transactions that caused
+// them and the netted flows between accounts.
+type BlockBalanceChanges struct {
+	Block   *types.BlockIdentifier      `json:"block_identifier"`
+	Changes []*TransactionBalanceChange `json:"changes"`
+	Flows   []*BalanceFlow              `json:"flows"`
+}
+
+// transactionBalanceChanges returns the balance changes in
+// a single *types.Transaction (ordered by first appearance).
+func (p *Parser) transactionBalanceChanges(
+	block *types.BlockIdentifier,
+	tx *types.Transaction,
+	blockRemoved bool,
+) ([]*TransactionBalanceChange, error) {
+	changes := []*TransactionBalanceChange{}
+	changeIndexes := map[string]int{}
+	for _, op := range tx.Operations {
+		skip, err := p.skipOperation(op)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to skip operation %s: %w",
+				types.PrintStruct(op),
+				err,
+			)
+		}
+		if skip {
+			continue
+		}
+
+		amountValue := op.Amount.Value
+		if blockRemoved {
+			negatedValue, err := types.NegateValue(amountValue)
+			if err != nil {
+				return nil, fmt.Errorf("failed to flip the sign of %s: %w", amountValue, err)
+			}
+			amountValue = negatedValue
+		}
+
+		key := fmt.Sprintf(
+			"%s/%s",
+			types.Hash(op.Account),
+			types.Hash(op.Amount.Currency),
+		)
+
+		index, ok := changeIndexes[key]
+		if !ok {
+			changeIndexes[key] = len(changes)
+			changes = append(changes, &TransactionBalanceChange{
+				BalanceChange: &BalanceChange{
+					Account:    op.Account,
+					Currency:   op.Amount.Currency,
+					Difference: amountValue,
+					Block:      block,
+				},
+				TransactionIdentifier: tx.TransactionIdentifier,
+				OperationIdentifiers:  []*types.OperationIdentifier{op.OperationIdentifier},
+			})
+			continue
+		}
+
+		change := changes[index]
+		newDifference, err := types.AddValues(change.Difference, amountValue)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to add %s and %s: %w",
+				change.Difference,
+				amountValue,
+				err,
+			)
+		}
+		change.Difference = newDifference
+		change.OperationIdentifiers = append(change.OperationIdentifiers, op.OperationIdentifier)
+	}
+
+	return changes, nil
+}
+
+// transactionFlows allocates the debits in a slice of
+// *TransactionBalanceChange (from a single transaction) to
+// the credits of the same *types.Currency (in order of
+// appearance). Any value that cannot be allocated (i.e. fees
+// or minted funds) is not considered a flow.
+func transactionFlows(
+	changes []*TransactionBalanceChange,
+) ([]*BalanceFlow, error) {
+	type remaining struct {
+		change *TransactionBalanceChange
+		value  *big.Int
+	}
+
+	senders := map[string][]*remaining{}
+	receivers := map[string][]*remaining{}
+	seenCurrencies := map[string]struct{}{}
+	currencies := []string{}
+	for _, change := range changes {
+		value, ok := new(big.Int).SetString(change.Difference, 10) // nolint
+		if !ok {
+			return nil, fmt.Errorf(
+				"%s is not an integer: %w",
+				change.Difference,
+				ErrBalanceFlowInvalidDifference,
+			)
+		}
+
+		key := types.Hash(change.Currency)
+		if _, ok := seenCurrencies[key]; !ok {
+			seenCurrencies[key] = struct{}{}
+			currencies = append(currencies, key)
+		}
+
+		switch value.Sign() {
+		case -1:
+			senders[key] = append(senders[key], &remaining{change, value.Abs(value)})
+		case 1:
+			receivers[key] = append(receivers[key], &remaining{change, value})
+		}
+	}
+
+	flows := []*BalanceFlow{}
+	for _, key := range currencies {
+		currencyReceivers := receivers[key]
+		for _, sender := range senders[key] {
+			for _, receiver := range currencyReceivers {
+				if sender.value.Sign() == 0 {
+					break
+				}
+
+				if receiver.value.Sign() == 0 {
+					continue
+				}
+
+				amount := new(big.Int).Set(sender.value)
+				if receiver.value.Cmp(amount) < 0 {
+					amount.Set(receiver.value)
+				}
+
+				sender.value.Sub(sender.value, amount)
+				receiver.value.Sub(receiver.value, amount)
+				flows = append(flows, &BalanceFlow{
+					From:     sender.change.Account,
+					To:       receiver.change.Account,
+					Currency: sender.change.Currency,
+					Amount:   amount.String(),
+					TransactionIdentifiers: []*types.TransactionIdentifier{
+						sender.change.TransactionIdentifier,
+					},
+				})
+			}
+		}
+	}
+
+	return flows, nil
+}
+
+// netFlows merges all *BalanceFlow between the same pair of
+// accounts (in either direction) for each *types.Currency. Flows
+// that net to zero are omitted.
+func netFlows(flows []*BalanceFlow) []*BalanceFlow {
+	type netFlow struct {
+		flow  *BalanceFlow
+		value *big.Int
+	}
+
+	netted := []*netFlow{}
+	nettedIndexes := map[string]int{}
+	for _, flow := range flows {
+		from := types.Hash(flow.From)
+		to := types.Hash(flow.To)
+
+		// Amounts are parsed in transactionFlows, so
+		// they are always valid.
+		value, _ := new(big.Int).SetString(flow.Amount, 10) // nolint
+
+		// Flows are keyed by the ordered pair of accounts
+		// so that transfers in opposite directions are
+		// netted against each other.
+		key := fmt.Sprintf("%s/%s/%s", from, to, types.Hash(flow.Currency))
+		if to < from {
+			key = fmt.Sprintf("%s/%s/%s", to, from, types.Hash(flow.Currency))
+		}
+
+		index, ok := nettedIndexes[key]
+		if !ok {
+			index = len(netted)
+			nettedIndexes[key] = index
+			netted = append(netted, &netFlow{
+				flow: &BalanceFlow{
+					From:                   flow.From,
+					To:                     flow.To,
+					Currency:               flow.Currency,
+					TransactionIdentifiers: []*types.TransactionIdentifier{},
+				},
+				value: big.NewInt(0),
+			})
+		}
+
+		n := netted[index]
+		if types.Hash(n.flow.From) == from {
+			n.value.Add(n.value, value)
+		} else {
+			n.value.Sub(n.value, value)
+		}
+
+		for _, txIdentifier := range flow.TransactionIdentifiers {
+			if !containsTransactionIdentifier(n.flow.TransactionIdentifiers, txIdentifier) {
+				n.flow.TransactionIdentifiers = append(n.flow.TransactionIdentifiers, txIdentifier)
+			}
+		}
+	}
+
+	result := []*BalanceFlow{}
+	for _, n := range netted {
+		switch n.value.Sign() {
+		case 0:
+			continue
+		case -1:
+			n.flow.From, n.flow.To = n.flow.To, n.flow.From
+		}
+
+		n.flow.Amount = new(big.Int).Abs(n.value).String()
+		result = append(result, n.flow)
+	}
+
+	return result
+}
+
+func containsTransactionIdentifier(
+	identifiers []*types.TransactionIdentifier,
+	identifier *types.TransactionIdentifier,
+) bool {
+	for _, existing := range identifiers {
+		if existing.Hash == identifier.Hash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TransactionBalanceChanges returns all balance changes in a
+// block attributed to the *types.Transaction (and operations)
+// that caused them, along with the netted flows between pairs
+// of accounts. Unlike BalanceChanges, balance changes for the
+// same account in different transactions are not merged. If a
+// block is being orphaned, the opposite of each balance change
+// (and flow) is returned.
+func (p *Parser) TransactionBalanceChanges(
+	ctx context.Context,
+	block *types.Block,
+	blockRemoved bool,
+) (*BlockBalanceChanges, error) {
+	allChanges := []*TransactionBalanceChange{}
+	allFlows := []*BalanceFlow{}
+	for _, tx := range block.Transactions {
+		changes, err := p.transactionBalanceChanges(block.BlockIdentifier, tx, blockRemoved)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to get balance changes for transaction %s: %w",
+				types.PrintStruct(tx.TransactionIdentifier),
+				err,
+			)
+		}
+
+		flows, err := transactionFlows(changes)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to get balance flows for transaction %s: %w",
+				types.PrintStruct(tx.TransactionIdentifier),
+				err,
+			)
+		}
+
+		allChanges = append(allChanges, changes...)
+		allFlows = append(allFlows, flows...)
+	}
+
+	return &BlockBalanceChanges{
+		Block:   block.BlockIdentifier,
+		Changes: allChanges,
+		Flows:   netFlows(allFlows),
+	}, nil
+}
@@ -279,6 +279,186 @@ func TestBalanceChanges(t *testing.T) {
 	}
 }
 
+func TestTransactionBalanceChanges(t *testing.T) {
+	var (
+		currency = &types.Currency{
+			Symbol:   "Blah",
+			Decimals: 2,
+		}
+		acct1 = &types.AccountIdentifier{Address: "acct1"}
+		acct2 = &types.AccountIdentifier{Address: "acct2"}
+		acct3 = &types.AccountIdentifier{Address: "acct3"}
+
+		blockIdentifier = &types.BlockIdentifier{
+			Hash:  "1",
+			Index: 1,
+		}
+
+		tx1 = &types.TransactionIdentifier{Hash: "tx1"}
+		tx2 = &types.TransactionIdentifier{Hash: "tx2"}
+	)
+
+	op := func(
+		index int64,
+		account *types.AccountIdentifier,
+		value string,
+		status string,
+	) *types.Operation {
+		return &types.Operation{
+			OperationIdentifier: &types.OperationIdentifier{Index: index},
+			Type:                "Transfer",
+			Status:              types.String(status),
+			Account:             account,
+			Amount: &types.Amount{
+				Value:    value,
+				Currency: currency,
+			},
+		}
+	}
+
+	block := &types.Block{
+		BlockIdentifier: blockIdentifier,
+		ParentBlockIdentifier: &types.BlockIdentifier{
+			Hash:  "0",
+			Index: 0,
+		},
+		Transactions: []*types.Transaction{
+			{
+				TransactionIdentifier: tx1,
+				Operations: []*types.Operation{
+					op(0, acct1, "-60", "Success"),
+					op(1, acct2, "60", "Success"),
+					op(2, acct1, "-40", "Success"),
+					op(3, acct3, "40", "Success"),
+					op(4, acct3, "1000", "Failure"),
+				},
+			},
+			{
+				TransactionIdentifier: tx2,
+				Operations: []*types.Operation{
+					op(0, acct2, "-70", "Success"),
+					op(1, acct1, "70", "Success"),
+				},
+			},
+		},
+	}
+
+	asserter, err := simpleAsserterConfiguration([]*types.OperationStatus{
+		{
+			Status:     "Success",
+			Successful: true,
+		},
+		{
+			Status:     "Failure",
+			Successful: false,
+		},
+	})
+	assert.NoError(t, err)
+	parser := New(asserter, nil, nil)
+
+	t.Run("block added", func(t *testing.T) {
+		changes, err := parser.TransactionBalanceChanges(context.Background(), block, false)
+		assert.NoError(t, err)
+		assert.Equal(t, &BlockBalanceChanges{
+			Block: blockIdentifier,
+			Changes: []*TransactionBalanceChange{
+				{
+					BalanceChange: &BalanceChange{
+						Account:    acct1,
+						Currency:   currency,
+						Block:      blockIdentifier,
+						Difference: "-100",
+					},
+					TransactionIdentifier: tx1,
+					OperationIdentifiers: []*types.OperationIdentifier{
+						{Index: 0},
+						{Index: 2},
+					},
+				},
+				{
+					BalanceChange: &BalanceChange{
+						Account:    acct2,
+						Currency:   currency,
+						Block:      blockIdentifier,
+						Difference: "60",
+					},
+					TransactionIdentifier: tx1,
+					OperationIdentifiers:  []*types.OperationIdentifier{{Index: 1}},
+				},
+				{
+					BalanceChange: &BalanceChange{
+						Account:    acct3,
+						Currency:   currency,
+						Block:      blockIdentifier,
+						Difference: "40",
+					},
+					TransactionIdentifier: tx1,
+					OperationIdentifiers:  []*types.OperationIdentifier{{Index: 3}},
+				},
+				{
+					BalanceChange: &BalanceChange{
+						Account:    acct2,
+						Currency:   currency,
+						Block:      blockIdentifier,
+						Difference: "-70",
+					},
+					TransactionIdentifier: tx2,
+					OperationIdentifiers:  []*types.OperationIdentifier{{Index: 0}},
+				},
+				{
+					BalanceChange: &BalanceChange{
+						Account:    acct1,
+						Currency:   currency,
+						Block:      blockIdentifier,
+						Difference: "70",
+					},
+					TransactionIdentifier: tx2,
+					OperationIdentifiers:  []*types.OperationIdentifier{{Index: 1}},
+				},
+			},
+			Flows: []*BalanceFlow{
+				{
+					From:                   acct2,
+					To:                     acct1,
+					Currency:               currency,
+					Amount:                 "10",
+					TransactionIdentifiers: []*types.TransactionIdentifier{tx1, tx2},
+				},
+				{
+					From:                   acct1,
+					To:                     acct3,
+					Currency:               currency,
+					Amount:                 "40",
+					TransactionIdentifiers: []*types.TransactionIdentifier{tx1},
+				},
+			},
+		}, changes)
+	})
+
+	t.Run("block removed", func(t *testing.T) {
+		changes, err := parser.TransactionBalanceChanges(context.Background(), block, true)
+		assert.NoError(t, err)
+		assert.Len(t, changes.Changes, 5)
+		assert.Equal(t, "100", changes.Changes[0].Difference)
+		assert.Equal(t, []*BalanceFlow{
+			{
+				From:                   acct1,
+				To:                     acct2,
+				Currency:               currency,
+				Amount:                 "10",
+				TransactionIdentifiers: []*types.TransactionIdentifier{tx1, tx2},
+			},
+			{
+				From:                   acct3,
+				To:                     acct1,
+				Currency:               currency,
+				Amount:                 "40",
+				TransactionIdentifiers: []*types.TransactionIdentifier{tx1},
+			},
+		}, changes.Flows)
+	})
+}
+
 func simpleTransactionFactory(
 	hash string,
 	address string,
@@ -45,6 +45,15 @@ var (
 	}
 )
 
+// Balance Changes Errors
+var (
+	ErrBalanceFlowInvalidDifference = errors.New("balance change difference is invalid")
+
+	BalanceChangesErrs = []error{
+		ErrBalanceFlowInvalidDifference,
+	}
+)
+
 // Match Operations Errors
 var (
 	ErrAccountMatchAccountMissing           = errors.New("account is missing")
@@ -73,6 +82,10 @@ var (
 	ErrEqualAddressesAccountIsNil     = errors.New("account is nil")
 	ErrEqualAddressesAddrMismatch     = errors.New("addresses do not match")
 
+	ErrSumAmountsInvalidPair = errors.New("sums must be compared between 2 descriptions")
+	ErrSumAmountsNotEqual    = errors.New("sums of amounts are not equal")
+	ErrSumAmountsNotOpposite = errors.New("sums of amounts are not opposite")
+
 	ErrMatchIndexValidIndexOutOfRange = errors.New("match index out of range")
 	ErrMatchIndexValidIndexIsNil      = errors.New("match index is nil")
 
@@ -80,6 +93,9 @@ var (
 	ErrMatchOperationsDescriptionsMissing   = errors.New("no descriptions to match")
 	ErrMatchOperationsMatchNotFound         = errors.New("unable to find match for operation")
 	ErrMatchOperationsDescriptionNotMatched = errors.New("could not find match for description")
+	ErrMatchOperationsTooFewRepeats         = errors.New(
+		"description matched fewer operations than required",
+	)
 
 	MatchOpsErrs = []error{
 		ErrAccountMatchAccountMissing,
@@ -101,12 +117,16 @@ var (
 		ErrEqualAddressesTooFewOperations,
 		ErrEqualAddressesAccountIsNil,
 		ErrEqualAddressesAddrMismatch,
+		ErrSumAmountsInvalidPair,
+		ErrSumAmountsNotEqual,
+		ErrSumAmountsNotOpposite,
 		ErrMatchIndexValidIndexOutOfRange,
 		ErrMatchIndexValidIndexIsNil,
 		ErrMatchOperationsNoOperations,
 		ErrMatchOperationsDescriptionsMissing,
 		ErrMatchOperationsMatchNotFound,
 		ErrMatchOperationsDescriptionNotMatched,
+		ErrMatchOperationsTooFewRepeats,
 	}
 )
 
@@ -117,6 +137,7 @@ func Err(err error) (bool, string) {
 	parserErrs := map[string][]error{
 		"intent error":           IntentErrs,
 		"match operations error": MatchOpsErrs,
+		"balance changes error":  BalanceChangesErrs,
 	}
 
 	for key, val := range parserErrs {
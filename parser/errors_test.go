@@ -36,6 +36,11 @@ func TestErr(t *testing.T) {
 			is:     true,
 			source: "match operations error",
 		},
+		"balance changes error": {
+			err:    ErrBalanceFlowInvalidDifference,
+			is:     true,
+			source: "balance changes error",
+		},
 	}
 
 	for name, test := range tests {
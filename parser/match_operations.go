@@ -136,6 +136,17 @@ type OperationDescription struct {
 	// to a particular description.
 	AllowRepeats bool
 
+	// MinRepeats is the minimum number of operations that must be
+	// matched to a description when AllowRepeats is true. If this
+	// is not populated, a single operation is sufficient.
+	MinRepeats int
+
+	// MaxRepeats is the maximum number of operations that can be
+	// matched to a description when AllowRepeats is true. Once
+	// reached, operations are matched to subsequent descriptions
+	// instead. If this is not populated, there is no maximum.
+	MaxRepeats int
+
 	// Optional indicates that not finding any operations that meet
 	// the description should not trigger an error.
 	Optional bool
@@ -171,6 +182,20 @@ type Descriptions struct {
 	// will error if all groups of operations addresses aren't equal.
 	EqualAddresses [][]int
 
+	// EqualSumAmounts are pairs of operation indices of
+	// OperationDescriptions. MatchOperations will error if the sum of
+	// all operation amounts matched to the first description is not
+	// equal to the sum of all operation amounts matched to the second.
+	EqualSumAmounts [][]int
+
+	// OppositeSumAmounts are pairs of operation indices of
+	// OperationDescriptions. MatchOperations will error if the sum of
+	// all operation amounts matched to the first description is not
+	// the opposite of the sum of all operation amounts matched to the
+	// second. This is useful for matching batch transfers where a
+	// single sender pays many receivers (using AllowRepeats).
+	OppositeSumAmounts [][]int
+
 	// ErrUnmatched indicates that an error should be returned
 	// if all operations cannot be matched to a description.
 	ErrUnmatched bool
@@ -354,6 +379,11 @@ func operationMatch(
 			continue
 		}
 
+		if matches[i] != nil && des.MaxRepeats > 0 &&
+			len(matches[i].Operations) >= des.MaxRepeats { // matched max times
+			continue
+		}
+
 		if len(des.Type) > 0 && des.Type != operation.Type {
 			continue
 		}
@@ -643,6 +673,97 @@ func compareOppositeMatches(
 	return nil
 }
 
+// sumAmounts returns the sum of the amounts
+// of a slice of operations.
+func sumAmounts(ops []*types.Operation) (*big.Int, error) {
+	sum := big.NewInt(0)
+	for _, op := range ops {
+		val, err := types.AmountValue(op.Amount)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to return big int representation of %s: %w",
+				types.PrintStruct(op.Amount),
+				err,
+			)
+		}
+
+		sum.Add(sum, val)
+	}
+
+	return sum, nil
+}
+
+// compareSumMatches ensures the summed amounts of pairs of
+// matched descriptions satisfy the sumChecker function.
+func compareSumMatches(
+	sumPairs [][]int,
+	matches []*Match,
+	sumChecker func(*big.Int, *big.Int) error,
+) error {
+	for _, sumMatch := range sumPairs {
+		if len(sumMatch) != oppositesLength { // cannot compare sums without exactly 2
+			return fmt.Errorf(
+				"cannot compare sums of %d descriptions: %w",
+				len(sumMatch),
+				ErrSumAmountsInvalidPair,
+			)
+		}
+
+		sums := make([]*big.Int, oppositesLength)
+		for i, index := range sumMatch {
+			if err := matchIndexValid(matches, index); err != nil {
+				return fmt.Errorf("match index %d is invalid: %w", index, err)
+			}
+
+			sum, err := sumAmounts(matches[index].Operations)
+			if err != nil {
+				return fmt.Errorf("unable to sum amounts for match index %d: %w", index, err)
+			}
+
+			sums[i] = sum
+		}
+
+		if err := sumChecker(sums[0], sums[1]); err != nil {
+			return fmt.Errorf(
+				"sums of match indices %d and %d are invalid: %w",
+				sumMatch[0],
+				sumMatch[1],
+				err,
+			)
+		}
+	}
+
+	return nil
+}
+
+// equalSums returns an error if a and b are not equal.
+func equalSums(a *big.Int, b *big.Int) error {
+	if a.Cmp(b) != 0 {
+		return fmt.Errorf(
+			"%s is not equal to %s: %w",
+			a.String(),
+			b.String(),
+			ErrSumAmountsNotEqual,
+		)
+	}
+
+	return nil
+}
+
+// oppositeSums returns an error if a and b are not opposites.
+func oppositeSums(a *big.Int, b *big.Int) error {
+	if new(big.Int).Add(a, b).Sign() != 0 {
+		return fmt.Errorf(
+			"%s is not the opposite of %s: %w",
+			a.String(),
+			b.String(),
+			ErrSumAmountsNotOpposite,
+		)
+	}
+
+	return nil
+}
+
 // comparisonMatch ensures collections of *types.Operation
 // have either equal or opposite amounts.
 func comparisonMatch(
@@ -664,6 +785,14 @@ func comparisonMatch(
 		return fmt.Errorf("both operation amounts not opposite and not zero: %w", err)
 	}
 
+	if err := compareSumMatches(descriptions.EqualSumAmounts, matches, equalSums); err != nil {
+		return fmt.Errorf("operation amount sums are not equal: %w", err)
+	}
+
+	if err := compareSumMatches(descriptions.OppositeSumAmounts, matches, oppositeSums); err != nil {
+		return fmt.Errorf("operation amount sums are not opposite: %w", err)
+	}
+
 	return nil
 }
 
@@ -732,6 +861,20 @@ func MatchOperations(
 				ErrMatchOperationsDescriptionNotMatched,
 			)
 		}
+
+		// Error if any repeated *OperationDescription is not
+		// matched enough times
+		des := descriptions.OperationDescriptions[i]
+		if matches[i] != nil && des.AllowRepeats &&
+			len(matches[i].Operations) < des.MinRepeats {
+			return nil, fmt.Errorf(
+				"%d operation description matched %d operations (min %d): %w",
+				i,
+				len(matches[i].Operations),
+				des.MinRepeats,
+				ErrMatchOperationsTooFewRepeats,
+			)
+		}
 	}
 
 	// Once matches are found, assert high-level descriptions between
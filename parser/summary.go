@@ -0,0 +1,339 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+	"github.com/dominant-strategies/mesh-sdk-go/utils"
+)
+
+const (
+	// TransferSummary is a group where funds are debited
+	// from some accounts and credited to others.
+	TransferSummary = "TRANSFER"
+
+	// FeeSummary is a group where funds are only debited
+	// (without using a coin).
+	FeeSummary = "FEE"
+
+	// RewardSummary is a group where funds are only credited
+	// (without creating a coin).
+	RewardSummary = "REWARD"
+
+	// CoinSpendSummary is a group where coins are only spent.
+	CoinSpendSummary = "COIN_SPEND"
+
+	// CoinCreateSummary is a group where coins are only created.
+	CoinCreateSummary = "COIN_CREATE"
+
+	// UnknownSummary is a group that does not move any funds.
+	UnknownSummary = "UNKNOWN"
+)
+
+// GroupSummary is a classification of the operations of a
+// particular *types.Currency in an *OperationGroup.
+type GroupSummary struct {
+	Type string
+
+	// From contains the accounts debited in the group.
+	From []*types.AccountIdentifier
+
+	// To contains the accounts credited in the group.
+	To []*types.AccountIdentifier
+
+	// Amount is the absolute value moved in the group. For
+	// transfers, this is the sum of all credits.
+	Amount   *big.Int
+	Currency *types.Currency
+
+	Operations []*types.Operation
+}
+
+// String returns a human-readable description of a *GroupSummary.
+func (g *GroupSummary) String() string {
+	if g.Currency == nil {
+		return g.Type
+	}
+
+	description := fmt.Sprintf("%s %s", g.Type, utils.PrettyAmount(g.Amount, g.Currency))
+	if len(g.From) > 0 {
+		description = fmt.Sprintf("%s from %s", description, prettyAccounts(g.From))
+	}
+
+	if len(g.To) > 0 {
+		description = fmt.Sprintf("%s to %s", description, prettyAccounts(g.To))
+	}
+
+	return description
+}
+
+// TransactionSummary is a structured description of
+// a *types.Transaction.
+type TransactionSummary struct {
+	TransactionIdentifier *types.TransactionIdentifier
+	Groups                []*GroupSummary
+
+	// Fees contains the sum of all FEE groups, the
+	// difference between debits and credits in TRANSFER
+	// groups and, for transactions that spend and create
+	// coins in separate groups, the difference between
+	// spent and created value (for each *types.Currency).
+	Fees []*types.Amount
+}
+
+// String returns a human-readable description of a
+// *TransactionSummary (one line per group and fee).
+func (s *TransactionSummary) String() string {
+	lines := []string{}
+	if s.TransactionIdentifier != nil {
+		lines = append(lines, fmt.Sprintf("Transaction %s", s.TransactionIdentifier.Hash))
+	}
+
+	for _, group := range s.Groups {
+		lines = append(lines, group.String())
+	}
+
+	for _, fee := range s.Fees {
+		value, _ := types.AmountValue(fee)
+		lines = append(lines, fmt.Sprintf("Fee: %s", utils.PrettyAmount(value, fee.Currency)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// prettyAccount returns the address of a *types.AccountIdentifier
+// (with its SubAccountIdentifier address, if populated).
+func prettyAccount(account *types.AccountIdentifier) string {
+	if account == nil {
+		return "unknown"
+	}
+
+	if account.SubAccount == nil {
+		return account.Address
+	}
+
+	return fmt.Sprintf("%s/%s", account.Address, account.SubAccount.Address)
+}
+
+func prettyAccounts(accounts []*types.AccountIdentifier) string {
+	addresses := make([]string, len(accounts))
+	for i, account := range accounts {
+		addresses[i] = prettyAccount(account)
+	}
+
+	return strings.Join(addresses, ", ")
+}
+
+// appendAccount appends a *types.AccountIdentifier to a slice
+// if it is not already present.
+func appendAccount(
+	accounts []*types.AccountIdentifier,
+	account *types.AccountIdentifier,
+) []*types.AccountIdentifier {
+	for _, existing := range accounts {
+		if types.Hash(existing) == types.Hash(account) {
+			return accounts
+		}
+	}
+
+	return append(accounts, account)
+}
+
+// coinActionOnly returns a boolean indicating if all
+// operations have a CoinChange with the provided action.
+func coinActionOnly(ops []*types.Operation, action types.CoinAction) bool {
+	for _, op := range ops {
+		if op.CoinChange == nil || op.CoinChange.CoinAction != action {
+			return false
+		}
+	}
+
+	return true
+}
+
+// summarizeCurrency classifies the operations of a particular
+// *types.Currency in an *OperationGroup. For transfers, it also
+// returns any value that was debited but not credited (like the
+// fee implied by a transaction that spends and creates coins
+// in the same group).
+func summarizeCurrency(
+	group *OperationGroup,
+	currency *types.Currency,
+) (*GroupSummary, *big.Int) {
+	summary := &GroupSummary{
+		From:       []*types.AccountIdentifier{},
+		To:         []*types.AccountIdentifier{},
+		Amount:     big.NewInt(0),
+		Currency:   currency,
+		Operations: []*types.Operation{},
+	}
+
+	debited := big.NewInt(0)
+	credited := big.NewInt(0)
+	debits := []*types.Operation{}
+	credits := []*types.Operation{}
+	for _, op := range group.Operations {
+		if op.Amount == nil || types.Hash(op.Amount.Currency) != types.Hash(currency) {
+			continue
+		}
+
+		summary.Operations = append(summary.Operations, op)
+
+		// Operations have already been asserted, so
+		// the amount is always valid.
+		value, err := types.AmountValue(op.Amount)
+		if err != nil {
+			continue
+		}
+
+		switch value.Sign() {
+		case -1:
+			debited.Add(debited, value)
+			debits = append(debits, op)
+			summary.From = appendAccount(summary.From, op.Account)
+		case 1:
+			credited.Add(credited, value)
+			credits = append(credits, op)
+			summary.To = appendAccount(summary.To, op.Account)
+		}
+	}
+
+	residual := big.NewInt(0)
+	switch {
+	case len(debits) > 0 && len(credits) > 0:
+		summary.Type = TransferSummary
+		summary.Amount = credited
+		residual.Sub(new(big.Int).Abs(debited), credited)
+	case len(debits) > 0 && coinActionOnly(debits, types.CoinSpent):
+		summary.Type = CoinSpendSummary
+		summary.Amount = debited.Abs(debited)
+	case len(debits) > 0:
+		summary.Type = FeeSummary
+		summary.Amount = debited.Abs(debited)
+	case len(credits) > 0 && coinActionOnly(credits, types.CoinCreated):
+		summary.Type = CoinCreateSummary
+		summary.Amount = credited
+	case len(credits) > 0:
+		summary.Type = RewardSummary
+		summary.Amount = credited
+	default:
+		summary.Type = UnknownSummary
+	}
+
+	return summary, residual
+}
+
+func coinActionKey(currency *types.Currency, action types.CoinAction) string {
+	return fmt.Sprintf("%s/%s", types.Hash(currency), action)
+}
+
+// addFee adds value to the fee of a *types.Currency
+// in a slice of fees.
+func addFee(
+	fees []*types.Amount,
+	value *big.Int,
+	currency *types.Currency,
+) []*types.Amount {
+	for _, fee := range fees {
+		if types.Hash(fee.Currency) != types.Hash(currency) {
+			continue
+		}
+
+		existing, _ := types.AmountValue(fee)
+		fee.Value = new(big.Int).Add(existing, value).String()
+		return fees
+	}
+
+	return append(fees, &types.Amount{
+		Value:    value.String(),
+		Currency: currency,
+	})
+}
+
+// Summarize classifies each *OperationGroup in a *types.Transaction
+// (per *types.Currency) into transfers, fees, rewards, coin spends
+// and coin creates. This should ONLY be called on transactions that
+// have already been asserted for correctness and only contain
+// successful operations (or operations without a status, like those
+// returned by /construction/parse).
+func Summarize(transaction *types.Transaction) *TransactionSummary {
+	summary := &TransactionSummary{
+		TransactionIdentifier: transaction.TransactionIdentifier,
+		Groups:                []*GroupSummary{},
+		Fees:                  []*types.Amount{},
+	}
+
+	coinBalances := []*types.Amount{}
+	coinActions := map[string]struct{}{}
+	for _, group := range GroupOperations(transaction) {
+		if len(group.Currencies) == 0 {
+			summary.Groups = append(summary.Groups, &GroupSummary{
+				Type:       UnknownSummary,
+				From:       []*types.AccountIdentifier{},
+				To:         []*types.AccountIdentifier{},
+				Operations: group.Operations,
+			})
+
+			continue
+		}
+
+		for _, currency := range group.Currencies {
+			groupSummary, residual := summarizeCurrency(group, currency)
+			summary.Groups = append(summary.Groups, groupSummary)
+
+			switch groupSummary.Type {
+			case TransferSummary:
+				if residual.Sign() > 0 {
+					summary.Fees = addFee(summary.Fees, residual, currency)
+				}
+			case FeeSummary:
+				summary.Fees = addFee(summary.Fees, groupSummary.Amount, currency)
+			case CoinSpendSummary:
+				coinBalances = addFee(coinBalances, groupSummary.Amount, currency)
+				coinActions[coinActionKey(currency, types.CoinSpent)] = struct{}{}
+			case CoinCreateSummary:
+				coinBalances = addFee(
+					coinBalances,
+					new(big.Int).Neg(groupSummary.Amount),
+					currency,
+				)
+				coinActions[coinActionKey(currency, types.CoinCreated)] = struct{}{}
+			}
+		}
+	}
+
+	// When coins are both spent and created, any value
+	// that is not created is paid as a fee.
+	for _, balance := range coinBalances {
+		_, spent := coinActions[coinActionKey(balance.Currency, types.CoinSpent)]
+		_, created := coinActions[coinActionKey(balance.Currency, types.CoinCreated)]
+		if !spent || !created {
+			continue
+		}
+
+		value, _ := types.AmountValue(balance)
+		if value.Sign() <= 0 {
+			continue
+		}
+
+		summary.Fees = addFee(summary.Fees, value, balance.Currency)
+	}
+
+	return summary
+}
@@ -0,0 +1,240 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+func TestSummarize(t *testing.T) {
+	var (
+		btc = &types.Currency{
+			Symbol:   "BTC",
+			Decimals: 8,
+		}
+		sender = &types.AccountIdentifier{
+			Address: "sender",
+		}
+		recipient = &types.AccountIdentifier{
+			Address: "recipient",
+			SubAccount: &types.SubAccountIdentifier{
+				Address: "savings",
+			},
+		}
+		miner = &types.AccountIdentifier{
+			Address: "miner",
+		}
+	)
+
+	op := func(
+		index int64,
+		related []int64,
+		account *types.AccountIdentifier,
+		value string,
+		coinAction types.CoinAction,
+	) *types.Operation {
+		operation := &types.Operation{
+			OperationIdentifier: &types.OperationIdentifier{Index: index},
+			Type:                "op",
+			Account:             account,
+			Amount: &types.Amount{
+				Value:    value,
+				Currency: btc,
+			},
+		}
+
+		for _, r := range related {
+			operation.RelatedOperations = append(
+				operation.RelatedOperations,
+				&types.OperationIdentifier{Index: r},
+			)
+		}
+
+		if len(coinAction) > 0 {
+			operation.CoinChange = &types.CoinChange{
+				CoinIdentifier: &types.CoinIdentifier{Identifier: "coin"},
+				CoinAction:     coinAction,
+			}
+		}
+
+		return operation
+	}
+
+	tests := map[string]struct {
+		transaction *types.Transaction
+
+		groups []*GroupSummary
+		fees   []*types.Amount
+		text   string
+	}{
+		"transfer with fee": {
+			transaction: &types.Transaction{
+				TransactionIdentifier: &types.TransactionIdentifier{Hash: "tx1"},
+				Operations: []*types.Operation{
+					op(0, nil, sender, "-100000000", ""),
+					op(1, []int64{0}, recipient, "100000000", ""),
+					op(2, nil, sender, "-1000", ""),
+				},
+			},
+			groups: []*GroupSummary{
+				{
+					Type:     TransferSummary,
+					From:     []*types.AccountIdentifier{sender},
+					To:       []*types.AccountIdentifier{recipient},
+					Amount:   big.NewInt(100000000),
+					Currency: btc,
+				},
+				{
+					Type:     FeeSummary,
+					From:     []*types.AccountIdentifier{sender},
+					To:       []*types.AccountIdentifier{},
+					Amount:   big.NewInt(1000),
+					Currency: btc,
+				},
+			},
+			fees: []*types.Amount{
+				{Value: "1000", Currency: btc},
+			},
+			text: "Transaction tx1\n" +
+				"TRANSFER 1.00000000 BTC from sender to recipient/savings\n" +
+				"FEE 0.00001000 BTC from sender\n" +
+				"Fee: 0.00001000 BTC",
+		},
+		"coin spend and create": {
+			transaction: &types.Transaction{
+				TransactionIdentifier: &types.TransactionIdentifier{Hash: "tx2"},
+				Operations: []*types.Operation{
+					op(0, nil, sender, "-5000", types.CoinSpent),
+					op(1, nil, recipient, "3000", types.CoinCreated),
+					op(2, nil, sender, "1500", types.CoinCreated),
+				},
+			},
+			groups: []*GroupSummary{
+				{
+					Type:     CoinSpendSummary,
+					From:     []*types.AccountIdentifier{sender},
+					To:       []*types.AccountIdentifier{},
+					Amount:   big.NewInt(5000),
+					Currency: btc,
+				},
+				{
+					Type:     CoinCreateSummary,
+					From:     []*types.AccountIdentifier{},
+					To:       []*types.AccountIdentifier{recipient},
+					Amount:   big.NewInt(3000),
+					Currency: btc,
+				},
+				{
+					Type:     CoinCreateSummary,
+					From:     []*types.AccountIdentifier{},
+					To:       []*types.AccountIdentifier{sender},
+					Amount:   big.NewInt(1500),
+					Currency: btc,
+				},
+			},
+			fees: []*types.Amount{
+				{Value: "500", Currency: btc},
+			},
+			text: "Transaction tx2\n" +
+				"COIN_SPEND 0.00005000 BTC from sender\n" +
+				"COIN_CREATE 0.00003000 BTC to recipient/savings\n" +
+				"COIN_CREATE 0.00001500 BTC to sender\n" +
+				"Fee: 0.00000500 BTC",
+		},
+		"coin spend and create in one group": {
+			transaction: &types.Transaction{
+				TransactionIdentifier: &types.TransactionIdentifier{Hash: "tx4"},
+				Operations: []*types.Operation{
+					op(0, nil, sender, "-5000", types.CoinSpent),
+					op(1, []int64{0}, recipient, "3000", types.CoinCreated),
+					op(2, []int64{0}, sender, "1500", types.CoinCreated),
+				},
+			},
+			groups: []*GroupSummary{
+				{
+					Type: TransferSummary,
+					From: []*types.AccountIdentifier{sender},
+					To: []*types.AccountIdentifier{
+						recipient,
+						sender,
+					},
+					Amount:   big.NewInt(4500),
+					Currency: btc,
+				},
+			},
+			fees: []*types.Amount{
+				{Value: "500", Currency: btc},
+			},
+			text: "Transaction tx4\n" +
+				"TRANSFER 0.00004500 BTC from sender to recipient/savings, sender\n" +
+				"Fee: 0.00000500 BTC",
+		},
+		"reward and nil amount": {
+			transaction: &types.Transaction{
+				TransactionIdentifier: &types.TransactionIdentifier{Hash: "tx3"},
+				Operations: []*types.Operation{
+					op(0, nil, miner, "625000000", ""),
+					{
+						OperationIdentifier: &types.OperationIdentifier{Index: 1},
+						Type:                "vote",
+						Account:             miner,
+					},
+				},
+			},
+			groups: []*GroupSummary{
+				{
+					Type:     RewardSummary,
+					From:     []*types.AccountIdentifier{},
+					To:       []*types.AccountIdentifier{miner},
+					Amount:   big.NewInt(625000000),
+					Currency: btc,
+				},
+				{
+					Type: UnknownSummary,
+					From: []*types.AccountIdentifier{},
+					To:   []*types.AccountIdentifier{},
+				},
+			},
+			fees: []*types.Amount{},
+			text: "Transaction tx3\n" +
+				"REWARD 6.25000000 BTC to miner\n" +
+				"UNKNOWN",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			summary := Summarize(test.transaction)
+
+			assert.Equal(t, test.transaction.TransactionIdentifier, summary.TransactionIdentifier)
+			assert.Len(t, summary.Groups, len(test.groups))
+			for i, group := range summary.Groups {
+				assert.Equal(t, test.groups[i].Type, group.Type)
+				assert.Equal(t, test.groups[i].From, group.From)
+				assert.Equal(t, test.groups[i].To, group.To)
+				assert.Equal(t, test.groups[i].Amount, group.Amount)
+				assert.Equal(t, test.groups[i].Currency, group.Currency)
+				assert.NotEmpty(t, group.Operations)
+			}
+			assert.Equal(t, test.fees, summary.Fees)
+			assert.Equal(t, test.text, summary.String())
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"math/big"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// BalanceTolerance is the maximum difference between a computed
+// and live balance of a *types.Currency that should be attributed
+// to rounding or fee dust instead of a reconciliation failure.
+//
+// A difference is within tolerance if it satisfies either the
+// Absolute or Relative threshold. An unset threshold is never
+// satisfied.
+type BalanceTolerance struct {
+	Currency *types.Currency `json:"currency"`
+
+	// Absolute is the maximum absolute difference (in atomic
+	// units) between the computed and live balance.
+	Absolute string `json:"absolute,omitempty"`
+
+	// Relative is the maximum absolute difference expressed as
+	// a fraction of the absolute live balance (i.e. 0.0001 allows
+	// a difference of 1 basis point).
+	Relative float64 `json:"relative,omitempty"`
+}
+
+// MatchBalanceTolerance returns the first *BalanceTolerance
+// for currency that the difference (live - computed) is within,
+// if it exists. Invalid values are never considered within
+// tolerance.
+func MatchBalanceTolerance(
+	tolerances []*BalanceTolerance,
+	currency *types.Currency,
+	difference string, // live - computed
+	liveBalance string,
+) *BalanceTolerance {
+	bigDifference, ok := new(big.Int).SetString(difference, 10) // nolint
+	if !ok {
+		return nil
+	}
+	bigDifference.Abs(bigDifference)
+
+	for _, tolerance := range tolerances {
+		if types.Hash(currency) != types.Hash(tolerance.Currency) {
+			continue
+		}
+
+		if withinAbsolute(tolerance, bigDifference) ||
+			withinRelative(tolerance, bigDifference, liveBalance) {
+			return tolerance
+		}
+	}
+
+	return nil
+}
+
+func withinAbsolute(tolerance *BalanceTolerance, difference *big.Int) bool {
+	if len(tolerance.Absolute) == 0 {
+		return false
+	}
+
+	maxDifference, ok := new(big.Int).SetString(tolerance.Absolute, 10) // nolint
+	if !ok {
+		return false
+	}
+
+	return difference.Cmp(maxDifference) <= 0
+}
+
+func withinRelative(
+	tolerance *BalanceTolerance,
+	difference *big.Int,
+	liveBalance string,
+) bool {
+	if tolerance.Relative <= 0 {
+		return false
+	}
+
+	bigLive, ok := new(big.Int).SetString(liveBalance, 10) // nolint
+	if !ok {
+		return false
+	}
+
+	maxDifference := new(big.Float).Mul(
+		new(big.Float).SetInt(bigLive.Abs(bigLive)),
+		big.NewFloat(tolerance.Relative),
+	)
+
+	return new(big.Float).SetInt(difference).Cmp(maxDifference) <= 0
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+func TestMatchBalanceTolerance(t *testing.T) {
+	var (
+		btc = &types.Currency{
+			Symbol:   "BTC",
+			Decimals: 8,
+		}
+		eth = &types.Currency{
+			Symbol:   "ETH",
+			Decimals: 18,
+		}
+		absolute = &BalanceTolerance{
+			Currency: btc,
+			Absolute: "10",
+		}
+		relative = &BalanceTolerance{
+			Currency: eth,
+			Relative: 0.001,
+		}
+	)
+
+	tests := map[string]struct {
+		tolerances  []*BalanceTolerance
+		currency    *types.Currency
+		difference  string
+		liveBalance string
+		expected    *BalanceTolerance
+	}{
+		"no tolerances": {
+			currency:    btc,
+			difference:  "1",
+			liveBalance: "100",
+		},
+		"currency mismatch": {
+			tolerances:  []*BalanceTolerance{absolute},
+			currency:    eth,
+			difference:  "1",
+			liveBalance: "100",
+		},
+		"within absolute": {
+			tolerances:  []*BalanceTolerance{absolute, relative},
+			currency:    btc,
+			difference:  "10",
+			liveBalance: "100",
+			expected:    absolute,
+		},
+		"within absolute negative": {
+			tolerances:  []*BalanceTolerance{absolute, relative},
+			currency:    btc,
+			difference:  "-5",
+			liveBalance: "100",
+			expected:    absolute,
+		},
+		"outside absolute": {
+			tolerances:  []*BalanceTolerance{absolute, relative},
+			currency:    btc,
+			difference:  "11",
+			liveBalance: "1000000",
+		},
+		"within relative": {
+			tolerances:  []*BalanceTolerance{absolute, relative},
+			currency:    eth,
+			difference:  "-1000",
+			liveBalance: "1000000",
+			expected:    relative,
+		},
+		"outside relative": {
+			tolerances:  []*BalanceTolerance{absolute, relative},
+			currency:    eth,
+			difference:  "1001",
+			liveBalance: "1000000",
+		},
+		"invalid difference": {
+			tolerances:  []*BalanceTolerance{absolute},
+			currency:    btc,
+			difference:  "hello",
+			liveBalance: "100",
+		},
+		"invalid live balance": {
+			tolerances:  []*BalanceTolerance{relative},
+			currency:    eth,
+			difference:  "1",
+			liveBalance: "hello",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(
+				t,
+				test.expected,
+				MatchBalanceTolerance(
+					test.tolerances,
+					test.currency,
+					test.difference,
+					test.liveBalance,
+				),
+			)
+		})
+	}
+}
@@ -17,6 +17,7 @@ package reconciler
 import (
 	"fmt"
 
+	"github.com/dominant-strategies/mesh-sdk-go/parser"
 	"github.com/dominant-strategies/mesh-sdk-go/types"
 )
 
@@ -111,6 +112,24 @@ func WithBacklogSize(size int) Option {
 	}
 }
 
+// WithBalanceTolerances sets the per-currency thresholds
+// under which a balance mismatch is not reported with
+// ReconciliationFailed (see ToleranceHandler).
+func WithBalanceTolerances(tolerances []*parser.BalanceTolerance) Option {
+	return func(r *Reconciler) {
+		r.balanceTolerances = tolerances
+	}
+}
+
+// WithCounter increments ToleratedReconciliationCounter
+// in the provided Counter each time a reconciliation is
+// within tolerance.
+func WithCounter(counter Counter) Option {
+	return func(r *Reconciler) {
+		r.counter = counter
+	}
+}
+
 // add a metaData map to fetcher
 func WithMetaData(metaData string) Option {
 	return func(r *Reconciler) {
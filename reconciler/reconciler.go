@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math/big"
 	"time"
 
 	"golang.org/x/sync/errgroup"
@@ -430,9 +431,53 @@ func (r *Reconciler) handleBalanceMismatch(
 		)
 	}
 
-	// If we didn't find a matching exemption,
-	// we should consider the reconciliation
-	// a failure.
+	// Check if the mismatch is small enough to be
+	// attributed to rounding or fee dust.
+	tolerance := parser.MatchBalanceTolerance(
+		r.balanceTolerances,
+		currency,
+		difference,
+		liveBalance,
+	)
+	if tolerance != nil {
+		if r.counter != nil {
+			_, err := r.counter.Update(
+				ctx,
+				ToleratedReconciliationCounter,
+				big.NewInt(1),
+			)
+			if err != nil {
+				return fmt.Errorf("unable to update tolerated reconciliation counter: %w", err)
+			}
+		}
+
+		toleranceHandler, ok := r.handler.(ToleranceHandler)
+		if !ok {
+			return r.handler.ReconciliationSucceeded(
+				ctx,
+				reconciliationType,
+				account,
+				currency,
+				liveBalance,
+				block,
+			)
+		}
+
+		return toleranceHandler.ReconciliationWithinTolerance(
+			ctx,
+			reconciliationType,
+			account,
+			currency,
+			computedBalance,
+			liveBalance,
+			block,
+			tolerance,
+		)
+	}
+
+	// If we didn't find a matching exemption
+	// or tolerance, we should consider the
+	// reconciliation a failure.
 	err := r.handler.ReconciliationFailed(
 		ctx,
 		reconciliationType,
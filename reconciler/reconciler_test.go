@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
+	"sync"
 	"testing"
 	"time"
 
@@ -1453,6 +1455,184 @@ func TestReconcile_ExemptOnlyActive(t *testing.T) {
 	}
 }
 
+type memoryCounter struct {
+	mutex  sync.Mutex
+	counts map[string]*big.Int
+}
+
+func (c *memoryCounter) Update(
+	ctx context.Context,
+	counter string,
+	amount *big.Int,
+) (*big.Int, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.counts == nil {
+		c.counts = map[string]*big.Int{}
+	}
+	if _, ok := c.counts[counter]; !ok {
+		c.counts[counter] = big.NewInt(0)
+	}
+	c.counts[counter].Add(c.counts[counter], amount)
+
+	return new(big.Int).Set(c.counts[counter]), nil
+}
+
+func (c *memoryCounter) get(counter string) *big.Int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if v, ok := c.counts[counter]; ok {
+		return new(big.Int).Set(v)
+	}
+
+	return big.NewInt(0)
+}
+
+func TestReconcile_WithinToleranceOnlyActive(t *testing.T) {
+	var (
+		block = &types.BlockIdentifier{
+			Hash:  "block 1",
+			Index: 1,
+		}
+		accountCurrency = &types.AccountCurrency{
+			Account: &types.AccountIdentifier{
+				Address: "addr 1",
+			},
+			Currency: &types.Currency{
+				Symbol:   "BTC",
+				Decimals: 8,
+			},
+		}
+		block2 = &types.BlockIdentifier{
+			Hash:  "block 2",
+			Index: 2,
+		}
+		tolerance = &parser.BalanceTolerance{
+			Currency: accountCurrency.Currency,
+			Absolute: "5",
+		}
+	)
+
+	lookupBalanceByBlocks := []bool{true, false}
+	for _, lookup := range lookupBalanceByBlocks {
+		for _, tolerant := range []bool{true, false} {
+			name := fmt.Sprintf("lookup balance by block %t tolerance handler %t", lookup, tolerant)
+			t.Run(name, func(t *testing.T) {
+				mockHelper := &mocks.Helper{}
+				mockHandler := &mocks.Handler{}
+				mockToleranceHandler := &mocks.ToleranceHandler{}
+				var handler Handler = mockHandler
+				if tolerant {
+					handler = struct {
+						*mocks.Handler
+						*mocks.ToleranceHandler
+					}{mockHandler, mockToleranceHandler}
+				}
+				counter := &memoryCounter{}
+				opts := []Option{
+					WithActiveConcurrency(1),
+					WithInactiveConcurrency(0),
+					WithBalanceTolerances([]*parser.BalanceTolerance{tolerance}),
+					WithCounter(counter),
+				}
+				if lookup {
+					opts = append(opts, WithLookupBalanceByBlock())
+				}
+				r := New(
+					mockHelper,
+					handler,
+					parser.New(nil, nil, nil),
+					opts...,
+				)
+				ctx := context.Background()
+				mtxn := &mockDatabase.Transaction{}
+				mtxn.On("Discard", mock.Anything).Once()
+				mockHelper.On("DatabaseTransaction", mock.Anything).Return(mtxn).Once()
+				mockHelper.On("CurrentBlock", mock.Anything, mtxn).Return(block2, nil).Once()
+				lookupIndex := block.Index
+				if !lookup {
+					lookupIndex = -1
+				}
+				mockHelper.On(
+					"LiveBalance",
+					mock.Anything,
+					accountCurrency.Account,
+					accountCurrency.Currency,
+					lookupIndex,
+				).Return(
+					&types.Amount{Value: "105", Currency: accountCurrency.Currency},
+					block2,
+					nil,
+				).Once()
+				mockHelper.On("CanonicalBlock", mock.Anything, mtxn, block2).Return(true, nil).Once()
+				mockHelper.On(
+					"ComputedBalance",
+					mock.Anything,
+					mtxn,
+					accountCurrency.Account,
+					accountCurrency.Currency,
+					block2.Index,
+				).Return(
+					&types.Amount{Value: "100", Currency: accountCurrency.Currency},
+					nil,
+				).Once()
+				if tolerant {
+					mockToleranceHandler.On(
+						"ReconciliationWithinTolerance",
+						mock.Anything,
+						ActiveReconciliation,
+						accountCurrency.Account,
+						accountCurrency.Currency,
+						"100",
+						"105",
+						block2,
+						tolerance,
+					).Return(nil).Once()
+				} else {
+					mockHandler.On(
+						"ReconciliationSucceeded",
+						mock.Anything,
+						ActiveReconciliation,
+						accountCurrency.Account,
+						accountCurrency.Currency,
+						"105",
+						block2,
+					).Return(nil).Once()
+				}
+
+				go func() {
+					err := r.Reconcile(ctx)
+					assert.NoError(t, err)
+				}()
+
+				err := r.QueueChanges(ctx, block, []*parser.BalanceChange{
+					{
+						Account:    accountCurrency.Account,
+						Currency:   accountCurrency.Currency,
+						Difference: "100",
+						Block:      block,
+					},
+				})
+				assert.NoError(t, err)
+
+				time.Sleep(1 * time.Second)
+
+				assert.Equal(
+					t,
+					big.NewInt(1),
+					counter.get(ToleratedReconciliationCounter),
+				)
+				mockHelper.AssertExpectations(t)
+				mockHandler.AssertExpectations(t)
+				mockToleranceHandler.AssertExpectations(t)
+				mtxn.AssertExpectations(t)
+			})
+		}
+	}
+}
+
 func TestReconcile_ExemptAddressOnlyActive(t *testing.T) {
 	var (
 		block = &types.BlockIdentifier{
@@ -16,6 +16,7 @@ package reconciler
 
 import (
 	"context"
+	"math/big"
 	"sync"
 	"time"
 
@@ -69,6 +70,13 @@ const (
 	AccountMissing = "ACCOUNT_MISSING"
 )
 
+const (
+	// ToleratedReconciliationCounter is the Counter updated
+	// each time a reconciliation is within tolerance (it
+	// matches modules.ToleratedReconciliationCounter).
+	ToleratedReconciliationCounter = "tolerated_reconciliations"
+)
+
 const (
 	// pruneActiveReconciliation indicates if historical balances
 	// should be pruned during active reconciliation.
@@ -238,6 +246,38 @@ type Handler interface {
 	) error
 }
 
+// ToleranceHandler is optionally implemented by a Handler
+// to be notified when the difference between the computed
+// and live balance does not exceed the *BalanceTolerance
+// configured for the currency. ReconciliationWithinTolerance
+// is invoked instead of ReconciliationFailed. If the Handler
+// does not implement ToleranceHandler, ReconciliationSucceeded
+// is invoked with the live balance.
+type ToleranceHandler interface {
+	ReconciliationWithinTolerance(
+		ctx context.Context,
+		reconciliationType string,
+		account *types.AccountIdentifier,
+		currency *types.Currency,
+		computedBalance string,
+		liveBalance string,
+		block *types.BlockIdentifier,
+		tolerance *parser.BalanceTolerance,
+	) error
+}
+
+// Counter is used by Reconciler to record the number of
+// reconciliations that were within tolerance (under
+// ToleratedReconciliationCounter).
+// *modules.CounterStorage implements this interface.
+type Counter interface {
+	Update(
+		ctx context.Context,
+		counter string,
+		amount *big.Int,
+	) (*big.Int, error)
+}
+
 // InactiveEntry is used to track the last
 // time that an *types.AccountCurrency was reconciled.
 type InactiveEntry struct {
@@ -262,6 +302,8 @@ type Reconciler struct {
 
 	lookupBalanceByBlock bool
 	interestingAccounts  []*types.AccountCurrency
+	balanceTolerances    []*parser.BalanceTolerance
+	counter              Counter
 	backlogSize          int
 	changeQueue          chan *parser.BalanceChange
 	inactiveFrequency    int64
@@ -0,0 +1,199 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dominant-strategies/mesh-sdk-go/internal/cache"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// DefaultCacheSize is the default number of responses
+// held in memory by a *ResponseCache.
+const DefaultCacheSize = cache.DefaultSize
+
+// CacheBackend persists immutable responses cached by a
+// *ResponseCache so that they survive restarts (and can be
+// shared by multiple servers). A database.Database-backed
+// implementation is provided by storage/modules.
+type CacheBackend = cache.Backend
+
+// CacheStats are the counters of a *ResponseCache. Expirations
+// is the number of tip-relative responses dropped because their
+// TTL elapsed.
+type CacheStats = cache.Stats
+
+// ResponseCache is an LRU cache of successful responses (optionally
+// persisted to a CacheBackend). Responses are keyed by the route
+// name and the hash of the normalized request body, so requests
+// that differ only in formatting or key order share an entry.
+//
+// Immutable responses (/block with a block hash, /block/transaction,
+// and /account/balance at a block hash) are cached until evicted.
+// Tip-relative responses (/network/status, /block and /account/balance
+// without a block hash, and /account/coins) are only cached in memory
+// for the configured TTL (and are not cached if it is 0).
+type ResponseCache struct {
+	lru    *cache.LRU
+	tipTTL time.Duration
+}
+
+// NewResponseCache constructs a new *ResponseCache that holds
+// up to size responses in memory (if size is <= 0, DefaultCacheSize
+// is used) and caches tip-relative responses for tipTTL. The backend
+// is optional.
+func NewResponseCache(
+	size int,
+	backend CacheBackend,
+	tipTTL time.Duration,
+) *ResponseCache {
+	return &ResponseCache{
+		lru:    cache.NewLRU(size, backend),
+		tipTTL: tipTTL,
+	}
+}
+
+// WithResponseCache serves responses from a *ResponseCache
+// (see ResponseCache for the requests that are cached).
+// ErrInternal is returned if a request body cannot be read
+// (and is added to the errors declared in /network/options).
+func WithResponseCache(cache *ResponseCache) Option {
+	return func(o *routerOptions) {
+		o.cached = true
+		o.middleware = append(o.middleware, func(route Route, next http.Handler) http.Handler {
+			// The codes are resolved once all options are applied.
+			return cache.middleware(o.codes.resolve(ErrInternal), route, next)
+		})
+	}
+}
+
+// Stats returns a copy of the current *CacheStats.
+func (c *ResponseCache) Stats() *CacheStats {
+	return c.lru.Stats()
+}
+
+// cacheKey parses a request body for the named route and returns
+// the key of its response and whether the response is immutable.
+// If the response should not be cached, ok is false.
+func cacheKey(route string, body []byte) (key string, immutable bool, ok bool) {
+	var request interface{}
+	switch route {
+	case "Block":
+		blockRequest := &types.BlockRequest{}
+		request = blockRequest
+		if err := json.Unmarshal(body, blockRequest); err != nil {
+			return "", false, false
+		}
+
+		immutable = blockRequest.BlockIdentifier != nil &&
+			blockRequest.BlockIdentifier.Hash != nil
+	case "BlockTransaction":
+		request = &types.BlockTransactionRequest{}
+		immutable = true
+	case "AccountBalance":
+		balanceRequest := &types.AccountBalanceRequest{}
+		request = balanceRequest
+		if err := json.Unmarshal(body, balanceRequest); err != nil {
+			return "", false, false
+		}
+
+		immutable = balanceRequest.BlockIdentifier != nil &&
+			balanceRequest.BlockIdentifier.Hash != nil
+	case "AccountCoins":
+		request = &types.AccountCoinsRequest{}
+	case "NetworkStatus":
+		request = &types.NetworkRequest{}
+	default:
+		return "", false, false
+	}
+
+	if err := json.Unmarshal(body, request); err != nil {
+		return "", false, false
+	}
+
+	return fmt.Sprintf("server/%s/%s", route, types.Hash(request)), immutable, true
+}
+
+// get returns the response stored for key
+// and a boolean indicating if it was found.
+func (c *ResponseCache) get(ctx context.Context, key string, immutable bool) ([]byte, bool) {
+	return c.lru.Get(ctx, key, immutable)
+}
+
+// set stores value for key in memory and in the
+// CacheBackend (if provided and the response
+// is immutable).
+func (c *ResponseCache) set(ctx context.Context, key string, value []byte, immutable bool) {
+	var expiration time.Time
+	if !immutable {
+		expiration = time.Now().Add(c.tipTTL)
+	}
+
+	c.lru.Set(ctx, key, value, expiration)
+}
+
+// middleware serves cached responses for the routes
+// described in ResponseCache and caches successful
+// responses returned by next. It returns internalErr
+// if the request body cannot be read.
+func (c *ResponseCache) middleware(
+	internalErr *types.Error,
+	route Route,
+	next http.Handler,
+) http.Handler {
+	if _, _, ok := cacheKey(route.Name, []byte("{}")); !ok {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := peekBody(r)
+		if err != nil {
+			readErr := *internalErr
+			readErr.Details = map[string]interface{}{
+				"route":   route.Name,
+				"context": err.Error(),
+			}
+			EncodeJSONResponse(&readErr, http.StatusInternalServerError, w)
+
+			return
+		}
+
+		key, immutable, ok := cacheKey(route.Name, body)
+		if !ok || (!immutable && c.tipTTL <= 0) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if value, ok := c.get(r.Context(), key, immutable); ok {
+			w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(value)
+			return
+		}
+
+		buffer := newResponseBuffer()
+		next.ServeHTTP(buffer, r)
+		if buffer.status == http.StatusOK {
+			c.set(r.Context(), key, buffer.body.Bytes(), immutable)
+		}
+
+		buffer.writeTo(w)
+	})
+}
@@ -0,0 +1,211 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+type mapCacheBackend struct {
+	mutex  sync.Mutex
+	values map[string][]byte
+}
+
+func (m *mapCacheBackend) Get(ctx context.Context, key string) (bool, []byte, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	value, ok := m.values[key]
+	return ok, value, nil
+}
+
+func (m *mapCacheBackend) Set(ctx context.Context, key string, value []byte) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.values[key] = value
+	return nil
+}
+
+type cacheBlockServicer struct {
+	blockCalls int
+}
+
+func (s *cacheBlockServicer) Block(
+	ctx context.Context,
+	request *types.BlockRequest,
+) (*types.BlockResponse, *types.Error) {
+	s.blockCalls++
+	if request.BlockIdentifier.Index != nil && *request.BlockIdentifier.Index > 1 {
+		return nil, &types.Error{Code: 1, Message: "block not found"}
+	}
+
+	return &types.BlockResponse{
+		Block: &types.Block{
+			BlockIdentifier: &types.BlockIdentifier{
+				Index: 1,
+				Hash:  "block 1",
+			},
+			ParentBlockIdentifier: &types.BlockIdentifier{
+				Index: 0,
+				Hash:  "block 0",
+			},
+			Timestamp: 1582833600000,
+		},
+	}, nil
+}
+
+func (s *cacheBlockServicer) BlockTransaction(
+	ctx context.Context,
+	request *types.BlockTransactionRequest,
+) (*types.BlockTransactionResponse, *types.Error) {
+	return nil, &types.Error{Code: 1, Message: "not implemented"}
+}
+
+func TestResponseCache(t *testing.T) {
+	var (
+		assert    = assert.New(t)
+		a         = newTestAsserter(t)
+		servicer  = &cacheBlockServicer{}
+		backend   = &mapCacheBackend{values: map[string][]byte{}}
+		cache     = NewResponseCache(10, backend, 100*time.Millisecond)
+		byHash    = `{"network_identifier":{"blockchain":"blockchain","network":"network"},"block_identifier":{"hash":"block 1"}}`
+		reordered = `{
+			"block_identifier": {"hash": "block 1"},
+			"network_identifier": {"network": "network", "blockchain": "blockchain"}
+		}`
+		byIndex  = `{"network_identifier":{"blockchain":"blockchain","network":"network"},"block_identifier":{"index":1}}`
+		notFound = `{"network_identifier":{"blockchain":"blockchain","network":"network"},"block_identifier":{"index":2}}`
+	)
+
+	serve := func(router http.Handler, body string) (int, string) {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(
+			recorder,
+			httptest.NewRequest(http.MethodPost, "/block", strings.NewReader(body)),
+		)
+
+		return recorder.Code, recorder.Body.String()
+	}
+
+	router := NewRouterWithOptions(
+		[]Router{NewBlockAPIController(servicer, a)},
+		WithResponseCache(cache),
+	)
+
+	// Requests by hash are cached regardless of
+	// the formatting of the request.
+	status, response := serve(router, byHash)
+	assert.Equal(http.StatusOK, status)
+	status, cachedResponse := serve(router, reordered)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal(response, cachedResponse)
+	assert.Equal(1, servicer.blockCalls)
+
+	// Requests by index are cached until the TTL elapses.
+	serve(router, byIndex)
+	serve(router, byIndex)
+	assert.Equal(2, servicer.blockCalls)
+	time.Sleep(150 * time.Millisecond)
+	serve(router, byIndex)
+	assert.Equal(3, servicer.blockCalls)
+
+	// Errors are not cached.
+	status, _ = serve(router, notFound)
+	assert.Equal(http.StatusInternalServerError, status)
+	serve(router, notFound)
+	assert.Equal(5, servicer.blockCalls)
+
+	assert.Equal(&CacheStats{
+		Hits:        2,
+		Misses:      5,
+		Expirations: 1,
+		Size:        2,
+	}, cache.Stats())
+
+	// Immutable responses are loaded from the
+	// backend by a new cache.
+	otherCache := NewResponseCache(10, backend, 0)
+	router = NewRouterWithOptions(
+		[]Router{NewBlockAPIController(servicer, a)},
+		WithResponseCache(otherCache),
+	)
+	status, cachedResponse = serve(router, byHash)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal(response, cachedResponse)
+	assert.Equal(5, servicer.blockCalls)
+
+	// Tip-relative responses are not cached
+	// when the TTL is 0.
+	serve(router, byIndex)
+	serve(router, byIndex)
+	assert.Equal(7, servicer.blockCalls)
+	assert.Equal(&CacheStats{
+		Hits:        1,
+		BackendHits: 1,
+		Size:        1,
+	}, otherCache.Stats())
+}
+
+func TestResponseCacheReadError(t *testing.T) {
+	var (
+		assert = assert.New(t)
+		cache  = NewResponseCache(10, nil, 0)
+		codes  = &ErrorCodes{Internal: 2002}
+	)
+
+	router := NewRouterWithOptions(
+		[]Router{NewBlockAPIController(&cacheBlockServicer{}, newTestAsserter(t))},
+		WithResponseCache(cache),
+		WithErrorCodes(codes),
+	)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(
+		http.MethodPost,
+		"/block",
+		iotest.ErrReader(errors.New("connection reset")),
+	))
+	assert.Equal(http.StatusInternalServerError, recorder.Code)
+
+	var rosettaErr types.Error
+	assert.NoError(json.NewDecoder(recorder.Body).Decode(&rosettaErr))
+	assert.Equal(types.Error{
+		Code:    2002,
+		Message: ErrInternal.Message,
+		Details: map[string]interface{}{
+			"route":   "Block",
+			"context": "connection reset",
+		},
+	}, rosettaErr)
+
+	o := &routerOptions{}
+	WithResponseCache(cache)(o)
+	WithErrorCodes(codes)(o)
+	assert.Equal([]*types.Error{codes.resolve(ErrInternal)}, o.declaredErrors())
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// ErrErrorCodeCollision is returned (in a *types.Error) by
+// /network/options when an error returned by the router has
+// the same code as a different error declared by the servicer.
+var ErrErrorCodeCollision = errors.New("error code already declared by a different error")
+
+// ErrorCodes overrides the codes of the errors returned by the
+// router created by NewRouterWithOptions (so that they do not
+// collide with the codes declared by an implementation). Codes
+// that are 0 are not overridden.
+type ErrorCodes struct {
+	// OfflineMode is the code of ErrOfflineMode.
+	OfflineMode int32
+
+	// RequestTooLarge is the code of ErrRequestTooLarge.
+	RequestTooLarge int32
+
+	// Internal is the code of ErrInternal.
+	Internal int32
+
+	// RateLimited is the code of ErrRateLimited.
+	RateLimited int32
+
+	// InvalidUpstreamResponse is the code of ErrInvalidUpstreamResponse
+	// (see WithGatewayErrorCodes).
+	InvalidUpstreamResponse int32
+
+	// UpstreamUnavailable is the code of ErrUpstreamUnavailable
+	// (see WithGatewayErrorCodes).
+	UpstreamUnavailable int32
+}
+
+// WithErrorCodes overrides the codes of the errors returned
+// by the router (see ErrorCodes).
+func WithErrorCodes(codes *ErrorCodes) Option {
+	return func(o *routerOptions) {
+		o.codes = codes
+	}
+}
+
+// resolve returns a copy of err with the code
+// configured in c (if any).
+func (c *ErrorCodes) resolve(err *types.Error) *types.Error {
+	newErr := *err
+	if c == nil {
+		return &newErr
+	}
+
+	var code int32
+	switch err {
+	case ErrOfflineMode:
+		code = c.OfflineMode
+	case ErrRequestTooLarge:
+		code = c.RequestTooLarge
+	case ErrInternal:
+		code = c.Internal
+	case ErrRateLimited:
+		code = c.RateLimited
+	case ErrInvalidUpstreamResponse:
+		code = c.InvalidUpstreamResponse
+	case ErrUpstreamUnavailable:
+		code = c.UpstreamUnavailable
+	}
+
+	if code != 0 {
+		newErr.Code = code
+	}
+
+	return &newErr
+}
+
+// declaredErrors returns the errors that may be
+// returned by the router with the configured options.
+func (o *routerOptions) declaredErrors() []*types.Error {
+	declared := []*types.Error{}
+	if o.offline {
+		declared = append(declared, o.codes.resolve(ErrOfflineMode))
+	}
+
+	if len(o.maxBodySizes) > 0 {
+		declared = append(declared, o.codes.resolve(ErrRequestTooLarge))
+	}
+
+	if o.recovery || o.cached || len(o.maxBodySizes) > 0 {
+		declared = append(declared, o.codes.resolve(ErrInternal))
+	}
+
+	if o.rateLimited {
+		declared = append(declared, o.codes.resolve(ErrRateLimited))
+	}
+
+	return declared
+}
+
+// declareErrors adds errs to the errors declared in allow (unless
+// they are already declared). It errors if the code of an error is
+// already declared by a different error.
+func declareErrors(allow *types.Allow, errs ...*types.Error) error {
+	for _, newErr := range errs {
+		declared := false
+		for _, existing := range allow.Errors {
+			if existing.Code != newErr.Code {
+				continue
+			}
+
+			if existing.Message != newErr.Message || existing.Retriable != newErr.Retriable {
+				return fmt.Errorf(
+					"%w: %q cannot use code %d (declared by %q)",
+					ErrErrorCodeCollision,
+					newErr.Message,
+					newErr.Code,
+					existing.Message,
+				)
+			}
+
+			declared = true
+			break
+		}
+
+		if !declared {
+			allow.Errors = append(allow.Errors, newErr)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+func TestDeclareErrors(t *testing.T) {
+	existing := &types.Error{Code: 1, Message: "node unavailable"}
+
+	var tests = map[string]struct {
+		declared []*types.Error
+		errs     []*types.Error
+
+		expectedErrors []*types.Error
+		expectedErr    error
+	}{
+		"new error": {
+			declared:       []*types.Error{existing},
+			errs:           []*types.Error{ErrOfflineMode},
+			expectedErrors: []*types.Error{existing, ErrOfflineMode},
+		},
+		"already declared": {
+			declared:       []*types.Error{existing, ErrOfflineMode},
+			errs:           []*types.Error{ErrOfflineMode},
+			expectedErrors: []*types.Error{existing, ErrOfflineMode},
+		},
+		"collision": {
+			declared:    []*types.Error{{Code: ErrOfflineMode.Code, Message: "other"}},
+			errs:        []*types.Error{ErrOfflineMode},
+			expectedErr: ErrErrorCodeCollision,
+		},
+		"collision with different retriable": {
+			declared: []*types.Error{{
+				Code:      ErrOfflineMode.Code,
+				Message:   ErrOfflineMode.Message,
+				Retriable: true,
+			}},
+			errs:        []*types.Error{ErrOfflineMode},
+			expectedErr: ErrErrorCodeCollision,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			allow := &types.Allow{Errors: test.declared}
+			err := declareErrors(allow, test.errs...)
+			if test.expectedErr != nil {
+				assert.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedErrors, allow.Errors)
+		})
+	}
+}
+
+func TestErrorCodesResolve(t *testing.T) {
+	var codes *ErrorCodes
+	assert.Equal(t, ErrOfflineMode, codes.resolve(ErrOfflineMode))
+
+	codes = &ErrorCodes{OfflineMode: 2000}
+	resolved := codes.resolve(ErrOfflineMode)
+	assert.Equal(t, int32(2000), resolved.Code)
+	assert.Equal(t, ErrOfflineMode.Message, resolved.Message)
+	assert.Equal(t, int32(1000), ErrOfflineMode.Code)
+}
@@ -0,0 +1,279 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+const (
+	// EventsStreamErrorEvent is the name of the server-sent
+	// event that contains a *types.Error when a stream fails.
+	EventsStreamErrorEvent = "error"
+
+	// eventsStreamKeepalive is how often a comment is
+	// written to an idle stream so that proxies do not
+	// close the connection.
+	eventsStreamKeepalive = 15 * time.Second
+)
+
+// EventsStreamAPIServicer streams *types.BlockEvents
+// as they are emitted.
+type EventsStreamAPIServicer interface {
+	// EventsBlocksStream calls emit with each *types.BlockEvent
+	// (in order of sequence) starting at request.Offset (or 0 if
+	// it is not populated) until ctx is done. If emit returns an
+	// error, the client has disconnected and EventsBlocksStream
+	// should return.
+	EventsBlocksStream(
+		ctx context.Context,
+		request *types.EventsBlocksRequest,
+		emit func(*types.BlockEvent) error,
+	) *types.Error
+}
+
+// A EventsStreamAPIController streams *types.BlockEvents emitted
+// by an EventsStreamAPIServicer as server-sent events.
+type EventsStreamAPIController struct {
+	service  EventsStreamAPIServicer
+	asserter *asserter.Asserter
+}
+
+// NewEventsStreamAPIController creates a default api controller
+func NewEventsStreamAPIController(
+	s EventsStreamAPIServicer,
+	asserter *asserter.Asserter,
+) Router {
+	return &EventsStreamAPIController{
+		service:  s,
+		asserter: asserter,
+	}
+}
+
+// Routes returns all of the api route for the EventsStreamAPIController
+func (c *EventsStreamAPIController) Routes() Routes {
+	return Routes{
+		{
+			"EventsBlocksStream",
+			strings.ToUpper("Post"),
+			"/events/blocks/stream",
+			c.EventsBlocksStream,
+		},
+	}
+}
+
+// EventsBlocksStream streams BlockEvents as server-sent events (with
+// the sequence of each event as its id and its type as the event name).
+// The stream resumes after the sequence in the Last-Event-ID header
+// (if provided) instead of request.Offset.
+func (c *EventsStreamAPIController) EventsBlocksStream(w http.ResponseWriter, r *http.Request) {
+	eventsBlocksRequest := &types.EventsBlocksRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&eventsBlocksRequest); err != nil {
+		EncodeJSONResponse(&types.Error{
+			Message: err.Error(),
+		}, http.StatusInternalServerError, w)
+
+		return
+	}
+
+	if lastEventID := r.Header.Get("Last-Event-ID"); len(lastEventID) > 0 {
+		sequence, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			EncodeJSONResponse(&types.Error{
+				Message: fmt.Sprintf("invalid Last-Event-ID %s: %s", lastEventID, err.Error()),
+			}, http.StatusInternalServerError, w)
+
+			return
+		}
+
+		eventsBlocksRequest.Offset = types.Int64(sequence + 1)
+	}
+
+	// Assert that EventsBlocksRequest is correct
+	if err := c.asserter.EventsBlocksRequest(eventsBlocksRequest); err != nil {
+		EncodeJSONResponse(&types.Error{
+			Message: err.Error(),
+		}, http.StatusInternalServerError, w)
+
+		return
+	}
+
+	stream := &eventsStreamWriter{
+		w:          w,
+		controller: http.NewResponseController(w),
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	if err := stream.flush(); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	go stream.keepalive(ctx, eventsStreamKeepalive)
+
+	serviceErr := c.service.EventsBlocksStream(ctx, eventsBlocksRequest, func(
+		event *types.BlockEvent,
+	) error {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("unable to marshal event: %w", err)
+		}
+
+		return stream.write(fmt.Sprintf(
+			"id: %d\nevent: %s\ndata: %s\n\n",
+			event.Sequence,
+			event.Type,
+			data,
+		))
+	})
+	if serviceErr != nil && ctx.Err() == nil {
+		data, err := json.Marshal(serviceErr)
+		if err != nil {
+			return
+		}
+
+		_ = stream.write(fmt.Sprintf("event: %s\ndata: %s\n\n", EventsStreamErrorEvent, data))
+	}
+}
+
+// eventsStreamWriter serializes writes to
+// a stream of server-sent events.
+type eventsStreamWriter struct {
+	mutex      sync.Mutex
+	w          io.Writer
+	controller *http.ResponseController
+}
+
+// write writes and flushes message.
+func (s *eventsStreamWriter) write(message string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, err := io.WriteString(s.w, message); err != nil {
+		return err
+	}
+
+	return s.controller.Flush()
+}
+
+// flush flushes any buffered data to the client.
+func (s *eventsStreamWriter) flush() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.controller.Flush()
+}
+
+// keepalive writes a comment to the stream
+// every interval until ctx is done.
+func (s *eventsStreamWriter) keepalive(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.write(": keepalive\n\n"); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// pollingEventsStreamer implements EventsStreamAPIServicer
+// by polling an EventsAPIServicer.
+type pollingEventsStreamer struct {
+	service  EventsAPIServicer
+	interval time.Duration
+}
+
+// NewPollingEventsStreamer returns an EventsStreamAPIServicer that
+// polls service every interval for new *types.BlockEvents. This
+// allows implementations that only support /events/blocks to serve
+// /events/blocks/stream.
+func NewPollingEventsStreamer(
+	service EventsAPIServicer,
+	interval time.Duration,
+) EventsStreamAPIServicer {
+	return &pollingEventsStreamer{
+		service:  service,
+		interval: interval,
+	}
+}
+
+// EventsBlocksStream emits the events returned by
+// EventsBlocks, polling when there are no new events.
+func (p *pollingEventsStreamer) EventsBlocksStream(
+	ctx context.Context,
+	request *types.EventsBlocksRequest,
+	emit func(*types.BlockEvent) error,
+) *types.Error {
+	offset := int64(0)
+	if request.Offset != nil {
+		offset = *request.Offset
+	}
+
+	for {
+		response, serviceErr := p.service.EventsBlocks(ctx, &types.EventsBlocksRequest{
+			NetworkIdentifier: request.NetworkIdentifier,
+			Offset:            types.Int64(offset),
+			Limit:             request.Limit,
+		})
+		if serviceErr != nil {
+			return serviceErr
+		}
+
+		emitted := false
+		for _, event := range response.Events {
+			if event.Sequence < offset {
+				continue
+			}
+
+			if err := emit(event); err != nil {
+				return nil
+			}
+
+			offset = event.Sequence + 1
+			emitted = true
+		}
+
+		// Fetch the next page immediately if
+		// there are more events available.
+		if emitted && offset <= response.MaxSequence {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(p.interval):
+		}
+	}
+}
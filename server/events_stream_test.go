@@ -0,0 +1,178 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+func testBlockEvents(count int) []*types.BlockEvent {
+	events := make([]*types.BlockEvent, count)
+	for i := range events {
+		events[i] = &types.BlockEvent{
+			Sequence: int64(i),
+			BlockIdentifier: &types.BlockIdentifier{
+				Index: int64(i),
+				Hash:  fmt.Sprintf("block %d", i),
+			},
+			Type: types.ADDED,
+		}
+	}
+
+	return events
+}
+
+type streamEventsServicer struct {
+	events []*types.BlockEvent
+	err    *types.Error
+
+	// deadline is set if the context of
+	// the last request has a deadline.
+	deadline bool
+}
+
+func (s *streamEventsServicer) EventsBlocksStream(
+	ctx context.Context,
+	request *types.EventsBlocksRequest,
+	emit func(*types.BlockEvent) error,
+) *types.Error {
+	_, s.deadline = ctx.Deadline()
+	for _, event := range s.events {
+		if event.Sequence < *request.Offset {
+			continue
+		}
+
+		if err := emit(event); err != nil {
+			return nil
+		}
+	}
+
+	return s.err
+}
+
+func TestEventsBlocksStream(t *testing.T) {
+	servicer := &streamEventsServicer{
+		events: testBlockEvents(3),
+		err:    &types.Error{Code: 1, Message: "node unavailable"},
+	}
+	router := NewRouter(NewEventsStreamAPIController(servicer, newTestAsserter(t)))
+
+	request := httptest.NewRequest(
+		http.MethodPost,
+		"/events/blocks/stream",
+		strings.NewReader(types.PrettyPrintStruct(&types.EventsBlocksRequest{
+			NetworkIdentifier: testNetwork,
+			Offset:            types.Int64(0),
+		})),
+	)
+
+	// Last-Event-ID takes precedence over the offset.
+	request.Header.Set("Last-Event-ID", "0")
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "text/event-stream", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, strings.Join([]string{
+		"id: 1",
+		"event: block_added",
+		`data: {"sequence":1,"block_identifier":{"index":1,"hash":"block 1"},"type":"block_added"}`,
+		"",
+		"id: 2",
+		"event: block_added",
+		`data: {"sequence":2,"block_identifier":{"index":2,"hash":"block 2"},"type":"block_added"}`,
+		"",
+		"event: error",
+		`data: {"code":1,"message":"node unavailable","retriable":false}`,
+		"",
+		"",
+	}, "\n"), recorder.Body.String())
+
+	// Invalid requests are rejected before streaming.
+	request = httptest.NewRequest(
+		http.MethodPost,
+		"/events/blocks/stream",
+		strings.NewReader(types.PrettyPrintStruct(&types.EventsBlocksRequest{
+			NetworkIdentifier: testNetwork,
+			Offset:            types.Int64(-1),
+		})),
+	)
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+}
+
+type pollingEventsServicer struct {
+	events []*types.BlockEvent
+	calls  int
+}
+
+func (s *pollingEventsServicer) EventsBlocks(
+	ctx context.Context,
+	request *types.EventsBlocksRequest,
+) (*types.EventsBlocksResponse, *types.Error) {
+	s.calls++
+
+	// Only 2 events are available until the third call
+	// and at most 2 events are returned at a time.
+	available := s.events[:2]
+	if s.calls >= 3 {
+		available = s.events
+	}
+
+	start := min(*request.Offset, int64(len(available)))
+	end := min(start+2, int64(len(available)))
+	return &types.EventsBlocksResponse{
+		MaxSequence: int64(len(available)) - 1,
+		Events:      available[start:end],
+	}, nil
+}
+
+func TestPollingEventsStreamer(t *testing.T) {
+	events := testBlockEvents(5)
+	servicer := &pollingEventsServicer{events: events}
+	streamer := NewPollingEventsStreamer(servicer, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errDone := errors.New("done")
+	var emitted []*types.BlockEvent
+	serviceErr := streamer.EventsBlocksStream(ctx, &types.EventsBlocksRequest{
+		NetworkIdentifier: testNetwork,
+		Offset:            types.Int64(1),
+	}, func(event *types.BlockEvent) error {
+		emitted = append(emitted, event)
+		if len(emitted) == 4 {
+			return errDone
+		}
+
+		return nil
+	})
+	assert.Nil(t, serviceErr)
+	assert.Equal(t, events[1:], emitted)
+	assert.Equal(t, 4, servicer.calls)
+}
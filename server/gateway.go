@@ -0,0 +1,536 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	"github.com/dominant-strategies/mesh-sdk-go/client"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+var (
+	// ErrInvalidUpstreamResponse is returned by a Gateway when
+	// an upstream server returns a response (or error) that is
+	// not valid. The assertion failure is included in the error
+	// details.
+	ErrInvalidUpstreamResponse = &types.Error{
+		Code:      1004,
+		Message:   "invalid upstream response",
+		Retriable: false,
+	}
+
+	// ErrUpstreamUnavailable is returned by a Gateway when
+	// a request could not be completed by any upstream server.
+	ErrUpstreamUnavailable = &types.Error{
+		Code:      1005,
+		Message:   "upstream unavailable",
+		Retriable: true,
+	}
+)
+
+// Gateway implements each servicer interface by forwarding
+// requests to upstream Mesh servers and validating their responses.
+// Requests are validated by the controllers returned by Routers.
+//
+// Requests are sent to each upstream in turn (starting with a
+// different upstream for each request) until one responds. A
+// *types.Error returned by an upstream is passed to the caller.
+type Gateway struct {
+	upstreams []*client.APIClient
+	asserter  *asserter.Asserter
+	codes     *ErrorCodes
+	next      uint64
+}
+
+// GatewayOption configures a Gateway.
+type GatewayOption func(g *Gateway)
+
+// WithGatewayErrorCodes overrides the codes of ErrInvalidUpstreamResponse
+// and ErrUpstreamUnavailable (see ErrorCodes).
+func WithGatewayErrorCodes(codes *ErrorCodes) GatewayOption {
+	return func(g *Gateway) {
+		g.codes = codes
+	}
+}
+
+// NewGateway creates a new instance of a Gateway. The provided
+// *asserter.Asserter is used to validate upstream responses, so it
+// must be a client asserter (like one created with
+// asserter.NewClientWithResponses) for the proxied network.
+func NewGateway(
+	upstreams []*client.APIClient,
+	asserter *asserter.Asserter,
+	options ...GatewayOption,
+) *Gateway {
+	g := &Gateway{
+		upstreams: upstreams,
+		asserter:  asserter,
+	}
+	for _, opt := range options {
+		opt(g)
+	}
+
+	return g
+}
+
+// Routers returns a Router for each API served by the Gateway.
+// The provided *asserter.Asserter is used to validate requests,
+// so it must be a server asserter (like one created with
+// asserter.NewServer).
+func (g *Gateway) Routers(asserter *asserter.Asserter) []Router {
+	return []Router{
+		NewAccountAPIController(g, asserter),
+		NewBlockAPIController(g, asserter),
+		NewCallAPIController(g, asserter),
+		NewConstructionAPIController(g, asserter),
+		NewEventsAPIController(g, asserter),
+		NewMempoolAPIController(g, asserter),
+		NewNetworkAPIController(g, asserter),
+		NewSearchAPIController(g, asserter),
+	}
+}
+
+// invalidUpstreamResponse returns ErrInvalidUpstreamResponse
+// with the assertion failure of the named route.
+func (g *Gateway) invalidUpstreamResponse(route string, err error) *types.Error {
+	newErr := g.codes.resolve(ErrInvalidUpstreamResponse)
+	newErr.Details = map[string]interface{}{
+		"route":   route,
+		"context": err.Error(),
+	}
+
+	return newErr
+}
+
+// forward makes a request to each upstream until one responds
+// and validates the response (or error) it returns.
+func forward[T any](
+	ctx context.Context,
+	g *Gateway,
+	route string,
+	request func(*client.APIClient) (T, *types.Error, error),
+	validate func(T) error,
+) (T, *types.Error) {
+	var empty T
+	var lastErr error
+	start := int(atomic.AddUint64(&g.next, 1) % uint64(max(len(g.upstreams), 1)))
+	for i := range g.upstreams {
+		upstream := g.upstreams[(start+i)%len(g.upstreams)]
+		response, clientErr, err := request(upstream)
+		if clientErr != nil {
+			if err := g.asserter.Error(clientErr); err != nil {
+				return empty, g.invalidUpstreamResponse(route, err)
+			}
+
+			return empty, clientErr
+		}
+
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if err := validate(response); err != nil {
+			return empty, g.invalidUpstreamResponse(route, err)
+		}
+
+		return response, nil
+	}
+
+	newErr := g.codes.resolve(ErrUpstreamUnavailable)
+	newErr.Details = map[string]interface{}{
+		"route": route,
+	}
+	if lastErr != nil {
+		newErr.Details["context"] = lastErr.Error()
+	}
+
+	return empty, newErr
+}
+
+// AccountBalance forwards /account/balance.
+func (g *Gateway) AccountBalance(
+	ctx context.Context,
+	request *types.AccountBalanceRequest,
+) (*types.AccountBalanceResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"AccountBalance",
+		func(upstream *client.APIClient) (*types.AccountBalanceResponse, *types.Error, error) {
+			return upstream.AccountAPI.AccountBalance(ctx, request)
+		},
+		func(response *types.AccountBalanceResponse) error {
+			return asserter.AccountBalanceResponse(request.BlockIdentifier, response)
+		},
+	)
+}
+
+// AccountCoins forwards /account/coins.
+func (g *Gateway) AccountCoins(
+	ctx context.Context,
+	request *types.AccountCoinsRequest,
+) (*types.AccountCoinsResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"AccountCoins",
+		func(upstream *client.APIClient) (*types.AccountCoinsResponse, *types.Error, error) {
+			return upstream.AccountAPI.AccountCoins(ctx, request)
+		},
+		asserter.AccountCoinsResponse,
+	)
+}
+
+// Block forwards /block.
+func (g *Gateway) Block(
+	ctx context.Context,
+	request *types.BlockRequest,
+) (*types.BlockResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"Block",
+		func(upstream *client.APIClient) (*types.BlockResponse, *types.Error, error) {
+			return upstream.BlockAPI.Block(ctx, request)
+		},
+		func(response *types.BlockResponse) error {
+			// A block may be omitted (if it does
+			// not exist yet).
+			if response.Block == nil {
+				return nil
+			}
+
+			if err := g.asserter.Block(response.Block); err != nil {
+				return err
+			}
+
+			for _, transactionIdentifier := range response.OtherTransactions {
+				if err := asserter.TransactionIdentifier(transactionIdentifier); err != nil {
+					return fmt.Errorf(
+						"other transaction %s is invalid: %w",
+						types.PrintStruct(transactionIdentifier),
+						err,
+					)
+				}
+			}
+
+			return nil
+		},
+	)
+}
+
+// BlockTransaction forwards /block/transaction.
+func (g *Gateway) BlockTransaction(
+	ctx context.Context,
+	request *types.BlockTransactionRequest,
+) (*types.BlockTransactionResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"BlockTransaction",
+		func(upstream *client.APIClient) (*types.BlockTransactionResponse, *types.Error, error) {
+			return upstream.BlockAPI.BlockTransaction(ctx, request)
+		},
+		func(response *types.BlockTransactionResponse) error {
+			return g.asserter.Transaction(response.Transaction)
+		},
+	)
+}
+
+// Call forwards /call.
+func (g *Gateway) Call(
+	ctx context.Context,
+	request *types.CallRequest,
+) (*types.CallResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"Call",
+		func(upstream *client.APIClient) (*types.CallResponse, *types.Error, error) {
+			return upstream.CallAPI.Call(ctx, request)
+		},
+		func(*types.CallResponse) error {
+			// The result of /call is implementation-specific.
+			return nil
+		},
+	)
+}
+
+// ConstructionCombine forwards /construction/combine.
+func (g *Gateway) ConstructionCombine(
+	ctx context.Context,
+	request *types.ConstructionCombineRequest,
+) (*types.ConstructionCombineResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"ConstructionCombine",
+		func(upstream *client.APIClient) (*types.ConstructionCombineResponse, *types.Error, error) {
+			return upstream.ConstructionAPI.ConstructionCombine(ctx, request)
+		},
+		asserter.ConstructionCombineResponse,
+	)
+}
+
+// ConstructionDerive forwards /construction/derive.
+func (g *Gateway) ConstructionDerive(
+	ctx context.Context,
+	request *types.ConstructionDeriveRequest,
+) (*types.ConstructionDeriveResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"ConstructionDerive",
+		func(upstream *client.APIClient) (*types.ConstructionDeriveResponse, *types.Error, error) {
+			return upstream.ConstructionAPI.ConstructionDerive(ctx, request)
+		},
+		asserter.ConstructionDeriveResponse,
+	)
+}
+
+// ConstructionHash forwards /construction/hash.
+func (g *Gateway) ConstructionHash(
+	ctx context.Context,
+	request *types.ConstructionHashRequest,
+) (*types.TransactionIdentifierResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"ConstructionHash",
+		func(upstream *client.APIClient) (*types.TransactionIdentifierResponse, *types.Error, error) {
+			return upstream.ConstructionAPI.ConstructionHash(ctx, request)
+		},
+		asserter.TransactionIdentifierResponse,
+	)
+}
+
+// ConstructionMetadata forwards /construction/metadata.
+func (g *Gateway) ConstructionMetadata(
+	ctx context.Context,
+	request *types.ConstructionMetadataRequest,
+) (*types.ConstructionMetadataResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"ConstructionMetadata",
+		func(upstream *client.APIClient) (*types.ConstructionMetadataResponse, *types.Error, error) {
+			return upstream.ConstructionAPI.ConstructionMetadata(ctx, request)
+		},
+		asserter.ConstructionMetadataResponse,
+	)
+}
+
+// ConstructionParse forwards /construction/parse.
+func (g *Gateway) ConstructionParse(
+	ctx context.Context,
+	request *types.ConstructionParseRequest,
+) (*types.ConstructionParseResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"ConstructionParse",
+		func(upstream *client.APIClient) (*types.ConstructionParseResponse, *types.Error, error) {
+			return upstream.ConstructionAPI.ConstructionParse(ctx, request)
+		},
+		func(response *types.ConstructionParseResponse) error {
+			return g.asserter.ConstructionParseResponse(response, request.Signed)
+		},
+	)
+}
+
+// ConstructionPayloads forwards /construction/payloads.
+func (g *Gateway) ConstructionPayloads(
+	ctx context.Context,
+	request *types.ConstructionPayloadsRequest,
+) (*types.ConstructionPayloadsResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"ConstructionPayloads",
+		func(upstream *client.APIClient) (*types.ConstructionPayloadsResponse, *types.Error, error) {
+			return upstream.ConstructionAPI.ConstructionPayloads(ctx, request)
+		},
+		asserter.ConstructionPayloadsResponse,
+	)
+}
+
+// ConstructionPreprocess forwards /construction/preprocess.
+func (g *Gateway) ConstructionPreprocess(
+	ctx context.Context,
+	request *types.ConstructionPreprocessRequest,
+) (*types.ConstructionPreprocessResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"ConstructionPreprocess",
+		func(upstream *client.APIClient) (*types.ConstructionPreprocessResponse, *types.Error, error) {
+			return upstream.ConstructionAPI.ConstructionPreprocess(ctx, request)
+		},
+		asserter.ConstructionPreprocessResponse,
+	)
+}
+
+// ConstructionSubmit forwards /construction/submit.
+func (g *Gateway) ConstructionSubmit(
+	ctx context.Context,
+	request *types.ConstructionSubmitRequest,
+) (*types.TransactionIdentifierResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"ConstructionSubmit",
+		func(upstream *client.APIClient) (*types.TransactionIdentifierResponse, *types.Error, error) {
+			return upstream.ConstructionAPI.ConstructionSubmit(ctx, request)
+		},
+		asserter.TransactionIdentifierResponse,
+	)
+}
+
+// EventsBlocks forwards /events/blocks.
+func (g *Gateway) EventsBlocks(
+	ctx context.Context,
+	request *types.EventsBlocksRequest,
+) (*types.EventsBlocksResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"EventsBlocks",
+		func(upstream *client.APIClient) (*types.EventsBlocksResponse, *types.Error, error) {
+			return upstream.EventsAPI.EventsBlocks(ctx, request)
+		},
+		asserter.EventsBlocksResponse,
+	)
+}
+
+// Mempool forwards /mempool.
+func (g *Gateway) Mempool(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.MempoolResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"Mempool",
+		func(upstream *client.APIClient) (*types.MempoolResponse, *types.Error, error) {
+			return upstream.MempoolAPI.Mempool(ctx, request)
+		},
+		func(response *types.MempoolResponse) error {
+			return asserter.MempoolTransactions(response.TransactionIdentifiers)
+		},
+	)
+}
+
+// MempoolTransaction forwards /mempool/transaction.
+func (g *Gateway) MempoolTransaction(
+	ctx context.Context,
+	request *types.MempoolTransactionRequest,
+) (*types.MempoolTransactionResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"MempoolTransaction",
+		func(upstream *client.APIClient) (*types.MempoolTransactionResponse, *types.Error, error) {
+			return upstream.MempoolAPI.MempoolTransaction(ctx, request)
+		},
+		func(response *types.MempoolTransactionResponse) error {
+			return g.asserter.Transaction(response.Transaction)
+		},
+	)
+}
+
+// NetworkList forwards /network/list.
+func (g *Gateway) NetworkList(
+	ctx context.Context,
+	request *types.MetadataRequest,
+) (*types.NetworkListResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"NetworkList",
+		func(upstream *client.APIClient) (*types.NetworkListResponse, *types.Error, error) {
+			return upstream.NetworkAPI.NetworkList(ctx, request)
+		},
+		asserter.NetworkListResponse,
+	)
+}
+
+// NetworkOptions forwards /network/options. ErrInvalidUpstreamResponse
+// and ErrUpstreamUnavailable are added to the allowed errors (the
+// response is invalid if an upstream declares a different error with
+// the same code).
+func (g *Gateway) NetworkOptions(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.NetworkOptionsResponse, *types.Error) {
+	response, rosettaErr := forward(
+		ctx,
+		g,
+		"NetworkOptions",
+		func(upstream *client.APIClient) (*types.NetworkOptionsResponse, *types.Error, error) {
+			return upstream.NetworkAPI.NetworkOptions(ctx, request)
+		},
+		asserter.NetworkOptionsResponse,
+	)
+	if rosettaErr != nil {
+		return nil, rosettaErr
+	}
+
+	if err := declareErrors(
+		response.Allow,
+		g.codes.resolve(ErrInvalidUpstreamResponse),
+		g.codes.resolve(ErrUpstreamUnavailable),
+	); err != nil {
+		return nil, g.invalidUpstreamResponse("NetworkOptions", err)
+	}
+
+	return response, nil
+}
+
+// NetworkStatus forwards /network/status.
+func (g *Gateway) NetworkStatus(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.NetworkStatusResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"NetworkStatus",
+		func(upstream *client.APIClient) (*types.NetworkStatusResponse, *types.Error, error) {
+			return upstream.NetworkAPI.NetworkStatus(ctx, request)
+		},
+		asserter.NetworkStatusResponse,
+	)
+}
+
+// SearchTransactions forwards /search/transactions.
+func (g *Gateway) SearchTransactions(
+	ctx context.Context,
+	request *types.SearchTransactionsRequest,
+) (*types.SearchTransactionsResponse, *types.Error) {
+	return forward(
+		ctx,
+		g,
+		"SearchTransactions",
+		func(upstream *client.APIClient) (*types.SearchTransactionsResponse, *types.Error, error) {
+			return upstream.SearchAPI.SearchTransactions(ctx, request)
+		},
+		g.asserter.SearchTransactionsResponse,
+	)
+}
@@ -0,0 +1,259 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	"github.com/dominant-strategies/mesh-sdk-go/client"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+var gatewayUndeclaredErr = &types.Error{
+	Code:    2,
+	Message: "undeclared",
+}
+
+type gatewayBlockServicer struct{}
+
+func (s *gatewayBlockServicer) Block(
+	ctx context.Context,
+	request *types.BlockRequest,
+) (*types.BlockResponse, *types.Error) {
+	block := &types.Block{
+		BlockIdentifier: &types.BlockIdentifier{
+			Hash:  "block 1",
+			Index: 1,
+		},
+		ParentBlockIdentifier: &types.BlockIdentifier{
+			Hash:  "block 0",
+			Index: 0,
+		},
+		Timestamp: asserter.MinUnixEpoch + 1,
+		Transactions: []*types.Transaction{
+			{
+				TransactionIdentifier: &types.TransactionIdentifier{Hash: "tx 1"},
+				Operations: []*types.Operation{
+					{
+						OperationIdentifier: &types.OperationIdentifier{Index: 0},
+						Type:                "transfer",
+						Status:              types.String("success"),
+					},
+				},
+			},
+		},
+	}
+
+	switch *request.BlockIdentifier.Index {
+	case 1:
+		return &types.BlockResponse{Block: block}, nil
+	case 2:
+		// The operation type is not allowed.
+		block.Transactions[0].Operations[0].Type = "burn"
+		return &types.BlockResponse{Block: block}, nil
+	case 3:
+		return nil, modeServicerErr
+	default:
+		return nil, gatewayUndeclaredErr
+	}
+}
+
+func (s *gatewayBlockServicer) BlockTransaction(
+	ctx context.Context,
+	request *types.BlockTransactionRequest,
+) (*types.BlockTransactionResponse, *types.Error) {
+	return nil, modeServicerErr
+}
+
+// newTestUpstream starts an upstream that declares
+// errs in /network/options (with modeServicerErr).
+func newTestUpstream(t *testing.T, errs ...*types.Error) (*client.APIClient, func()) {
+	a := newTestAsserter(t)
+	upstream := httptest.NewServer(NewRouter(
+		NewNetworkAPIController(&modeNetworkServicer{errors: errs}, a),
+		NewBlockAPIController(&gatewayBlockServicer{}, a),
+	))
+
+	return client.NewAPIClient(client.NewConfiguration(upstream.URL, "test", nil)), upstream.Close
+}
+
+func TestGateway(t *testing.T) {
+	ctx := context.Background()
+
+	clientAsserter, err := asserter.NewClientWithOptions(
+		testNetwork,
+		&types.BlockIdentifier{Hash: "block 0", Index: 0},
+		[]string{"transfer"},
+		[]*types.OperationStatus{{Status: "success", Successful: true}},
+		[]*types.Error{modeServicerErr},
+		nil,
+		&asserter.Validations{Enabled: false},
+	)
+	assert.NoError(t, err)
+
+	upstream, closeUpstream := newTestUpstream(t)
+	defer closeUpstream()
+
+	// The unavailable upstream is skipped.
+	unavailable, closeUnavailable := newTestUpstream(t)
+	closeUnavailable()
+
+	gateway := NewGateway([]*client.APIClient{unavailable, upstream}, clientAsserter)
+
+	var tests = map[string]struct {
+		index int64
+
+		expectedHash string
+		expectedErr  *types.Error
+	}{
+		"valid block": {
+			index:        1,
+			expectedHash: "block 1",
+		},
+		"invalid block": {
+			index:       2,
+			expectedErr: ErrInvalidUpstreamResponse,
+		},
+		"declared error": {
+			index:       3,
+			expectedErr: modeServicerErr,
+		},
+		"undeclared error": {
+			index:       4,
+			expectedErr: ErrInvalidUpstreamResponse,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			response, rosettaErr := gateway.Block(ctx, &types.BlockRequest{
+				NetworkIdentifier: testNetwork,
+				BlockIdentifier:   &types.PartialBlockIdentifier{Index: types.Int64(test.index)},
+			})
+			if test.expectedErr != nil {
+				assert.Nil(t, response)
+				assert.Equal(t, test.expectedErr.Code, rosettaErr.Code)
+				return
+			}
+
+			assert.Nil(t, rosettaErr)
+			assert.Equal(t, test.expectedHash, response.Block.BlockIdentifier.Hash)
+		})
+	}
+
+	t.Run("network options", func(t *testing.T) {
+		response, rosettaErr := gateway.NetworkOptions(ctx, &types.NetworkRequest{
+			NetworkIdentifier: testNetwork,
+		})
+		assert.Nil(t, rosettaErr)
+		assert.Equal(t, []*types.Error{
+			modeServicerErr,
+			ErrInvalidUpstreamResponse,
+			ErrUpstreamUnavailable,
+		}, response.Allow.Errors)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		router := NewRouter(gateway.Routers(newTestAsserter(t))...)
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(
+			http.MethodPost,
+			"/block",
+			strings.NewReader(`{"network_identifier":{"blockchain":"other","network":"network"}}`),
+		))
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "network identifier")
+	})
+
+	t.Run("no upstream available", func(t *testing.T) {
+		gateway := NewGateway([]*client.APIClient{unavailable}, clientAsserter)
+		response, rosettaErr := gateway.NetworkStatus(ctx, &types.NetworkRequest{
+			NetworkIdentifier: testNetwork,
+		})
+		assert.Nil(t, response)
+		assert.Equal(t, ErrUpstreamUnavailable.Code, rosettaErr.Code)
+		assert.True(t, rosettaErr.Retriable)
+	})
+}
+
+func TestGatewayErrorCodes(t *testing.T) {
+	ctx := context.Background()
+
+	clientAsserter, err := asserter.NewClientWithOptions(
+		testNetwork,
+		&types.BlockIdentifier{Hash: "block 0", Index: 0},
+		[]string{"transfer"},
+		[]*types.OperationStatus{{Status: "success", Successful: true}},
+		[]*types.Error{modeServicerErr},
+		nil,
+		&asserter.Validations{Enabled: false},
+	)
+	assert.NoError(t, err)
+
+	// The upstream declares a different error with
+	// the code of ErrInvalidUpstreamResponse.
+	collidingErr := &types.Error{Code: ErrInvalidUpstreamResponse.Code, Message: "other"}
+	upstream, closeUpstream := newTestUpstream(t, collidingErr)
+	defer closeUpstream()
+
+	networkRequest := &types.NetworkRequest{NetworkIdentifier: testNetwork}
+
+	t.Run("collision", func(t *testing.T) {
+		gateway := NewGateway([]*client.APIClient{upstream}, clientAsserter)
+		response, rosettaErr := gateway.NetworkOptions(ctx, networkRequest)
+		assert.Nil(t, response)
+		assert.Equal(t, ErrInvalidUpstreamResponse.Code, rosettaErr.Code)
+		assert.Contains(t, rosettaErr.Details["context"], ErrErrorCodeCollision.Error())
+	})
+
+	t.Run("overridden codes", func(t *testing.T) {
+		gateway := NewGateway(
+			[]*client.APIClient{upstream},
+			clientAsserter,
+			WithGatewayErrorCodes(&ErrorCodes{
+				InvalidUpstreamResponse: 2004,
+				UpstreamUnavailable:     2005,
+			}),
+		)
+
+		invalidErr := *ErrInvalidUpstreamResponse
+		invalidErr.Code = 2004
+		unavailableErr := *ErrUpstreamUnavailable
+		unavailableErr.Code = 2005
+
+		response, rosettaErr := gateway.NetworkOptions(ctx, networkRequest)
+		assert.Nil(t, rosettaErr)
+		assert.Equal(t, []*types.Error{
+			modeServicerErr,
+			collidingErr,
+			&invalidErr,
+			&unavailableErr,
+		}, response.Allow.Errors)
+
+		blockResponse, rosettaErr := gateway.Block(ctx, &types.BlockRequest{
+			NetworkIdentifier: testNetwork,
+			BlockIdentifier:   &types.PartialBlockIdentifier{Index: types.Int64(2)},
+		})
+		assert.Nil(t, blockResponse)
+		assert.Equal(t, int32(2004), rosettaErr.Code)
+	})
+}
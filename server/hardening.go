@@ -0,0 +1,258 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+var (
+	// ErrRequestTooLarge is returned when a request body
+	// exceeds the limit set by WithMaxBodySize.
+	ErrRequestTooLarge = &types.Error{
+		Code:      1001,
+		Message:   "request body too large",
+		Retriable: false,
+	}
+
+	// ErrInternal is returned when a servicer panics
+	// (see WithPanicRecovery).
+	ErrInternal = &types.Error{
+		Code:      1002,
+		Message:   "internal server error",
+		Retriable: false,
+	}
+)
+
+// streamingRoutes are the names of the routes that hold
+// their response open. A timeout for all routes does not
+// apply to them (see WithTimeout).
+var streamingRoutes = map[string]struct{}{
+	"EventsBlocksStream": {},
+}
+
+// defaultAllowedHeaders are the request headers
+// allowed by CorsMiddleware.
+var defaultAllowedHeaders = []string{"Origin", "X-Requested-With", "Content-Type", "Accept"}
+
+// CORSConfig configures the CORS headers added by WithCORS.
+type CORSConfig struct {
+	// AllowedOrigins are the origins that may make requests.
+	// "*" allows all origins.
+	AllowedOrigins []string
+
+	// AllowedHeaders are the request headers that may be
+	// used. If empty, the headers allowed by CorsMiddleware
+	// are used.
+	AllowedHeaders []string
+
+	// MaxAge is how long the result of a preflight
+	// request may be cached (omitted if 0).
+	MaxAge time.Duration
+}
+
+// allowedOrigin returns the value of the Access-Control-Allow-Origin
+// header for origin (or an empty string if origin is not allowed).
+func (c *CORSConfig) allowedOrigin(origin string) string {
+	for _, allowed := range c.AllowedOrigins {
+		if allowed == "*" {
+			return "*"
+		}
+
+		if len(origin) > 0 && strings.EqualFold(allowed, origin) {
+			return origin
+		}
+	}
+
+	return ""
+}
+
+// WithCORS adds CORS headers to responses for requests from the
+// allowed origins and responds to OPTIONS (preflight) requests.
+// Unlike CorsMiddleware, only the configured origins and headers
+// are allowed.
+func WithCORS(config *CORSConfig) Option {
+	return func(o *routerOptions) {
+		o.cors = config
+	}
+}
+
+func corsMiddleware(config *CORSConfig, next http.Handler) http.Handler {
+	allowedHeaders := config.AllowedHeaders
+	if len(allowedHeaders) == 0 {
+		allowedHeaders = defaultAllowedHeaders
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Origin")
+		if origin := config.allowedOrigin(r.Header.Get("Origin")); len(origin) > 0 {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Allow-Headers", strings.Join(allowedHeaders, ", "))
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+			if config.MaxAge > 0 {
+				w.Header().Set(
+					"Access-Control-Max-Age",
+					strconv.FormatInt(int64(config.MaxAge.Seconds()), 10),
+				)
+			}
+		}
+
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// WithMaxBodySize rejects requests with a body larger than limit
+// bytes with ErrRequestTooLarge (which is added to the errors
+// declared in /network/options). If routes are provided, the limit
+// only applies to the named routes (overriding any limit for all
+// routes).
+func WithMaxBodySize(limit int64, routes ...string) Option {
+	return func(o *routerOptions) {
+		if len(routes) == 0 {
+			routes = []string{allRoutes}
+		}
+
+		for _, route := range routes {
+			o.maxBodySizes[route] = limit
+		}
+	}
+}
+
+// WithTimeout sets a deadline on the context.Context passed to the
+// servicer. If routes are provided, the timeout only applies to the
+// named routes (overriding any timeout for all routes). A timeout for
+// all routes does not apply to streaming routes (like
+// EventsBlocksStream), which would otherwise be closed when it
+// expires; name them explicitly to bound their duration.
+func WithTimeout(timeout time.Duration, routes ...string) Option {
+	return func(o *routerOptions) {
+		if len(routes) == 0 {
+			routes = []string{allRoutes}
+		}
+
+		for _, route := range routes {
+			o.timeouts[route] = timeout
+		}
+	}
+}
+
+// limitsMiddleware enforces the body size limit and
+// timeout of a route (if any).
+func (o *routerOptions) limitsMiddleware(route Route, next http.Handler) http.Handler {
+	limit, ok := o.maxBodySizes[route.Name]
+	if !ok {
+		limit = o.maxBodySizes[allRoutes]
+	}
+
+	timeout, ok := o.timeouts[route.Name]
+	if _, streaming := streamingRoutes[route.Name]; !ok && !streaming {
+		timeout = o.timeouts[allRoutes]
+	}
+
+	if limit <= 0 && timeout <= 0 {
+		return next
+	}
+
+	tooLargeErr := o.codes.resolve(ErrRequestTooLarge)
+	internalErr := o.codes.resolve(ErrInternal)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if limit > 0 {
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+
+			// A body without a Content-Length is only
+			// known to be too large once it is read.
+			if _, err := peekBody(r); err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					newErr := *tooLargeErr
+					EncodeJSONResponse(&newErr, http.StatusInternalServerError, w)
+					return
+				}
+
+				newErr := *internalErr
+				newErr.Details = map[string]interface{}{
+					"route":   route.Name,
+					"context": err.Error(),
+				}
+				EncodeJSONResponse(&newErr, http.StatusInternalServerError, w)
+
+				return
+			}
+		}
+
+		if timeout > 0 {
+			ctx, cancel := context.WithTimeout(r.Context(), timeout)
+			defer cancel()
+
+			r = r.WithContext(ctx)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// WithPanicRecovery recovers from panics in servicers (and
+// other middleware), logging the panic and returning ErrInternal
+// (with the route in Details) instead of closing the connection.
+// ErrInternal is added to the errors declared in /network/options.
+func WithPanicRecovery() Option {
+	return func(o *routerOptions) {
+		o.recovery = true
+	}
+}
+
+// recoveryMiddleware returns ErrInternal
+// when next panics.
+func (o *routerOptions) recoveryMiddleware(route Route, next http.Handler) http.Handler {
+	resolvedErr := o.codes.resolve(ErrInternal)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			p := recover()
+			if p == nil {
+				return
+			}
+
+			// http.ErrAbortHandler is used to
+			// intentionally abort a response.
+			if p == http.ErrAbortHandler { // nolint
+				panic(p)
+			}
+
+			log.Printf("%s: panic: %v\n%s", route.Name, p, debug.Stack())
+
+			internalErr := *resolvedErr
+			internalErr.Details = map[string]interface{}{
+				"route": route.Name,
+			}
+			EncodeJSONResponse(&internalErr, http.StatusInternalServerError, w)
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,256 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+type hardeningNetworkServicer struct {
+	modeNetworkServicer
+
+	deadlines map[string]bool
+}
+
+func (s *hardeningNetworkServicer) NetworkList(
+	ctx context.Context,
+	request *types.MetadataRequest,
+) (*types.NetworkListResponse, *types.Error) {
+	_, s.deadlines["NetworkList"] = ctx.Deadline()
+	return s.modeNetworkServicer.NetworkList(ctx, request)
+}
+
+func (s *hardeningNetworkServicer) NetworkOptions(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.NetworkOptionsResponse, *types.Error) {
+	_, s.deadlines["NetworkOptions"] = ctx.Deadline()
+	return s.modeNetworkServicer.NetworkOptions(ctx, request)
+}
+
+func (s *hardeningNetworkServicer) NetworkStatus(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.NetworkStatusResponse, *types.Error) {
+	panic("unexpected state")
+}
+
+func TestHardening(t *testing.T) {
+	servicer := &hardeningNetworkServicer{deadlines: map[string]bool{}}
+	router := NewRouterWithOptions(
+		[]Router{NewNetworkAPIController(servicer, newTestAsserter(t))},
+		WithCORS(&CORSConfig{
+			AllowedOrigins: []string{"https://example.com"},
+			AllowedHeaders: []string{"Content-Type"},
+			MaxAge:         time.Minute,
+		}),
+		WithMaxBodySize(8),
+		WithMaxBodySize(1024, "NetworkOptions", "NetworkStatus"),
+		WithTimeout(time.Second, "NetworkOptions"),
+		WithPanicRecovery(),
+	)
+
+	request := types.PrettyPrintStruct(&types.NetworkRequest{
+		NetworkIdentifier: testNetwork,
+	})
+	serve := func(method string, path string, origin string, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, strings.NewReader(body))
+		if len(origin) > 0 {
+			r.Header.Set("Origin", origin)
+		}
+
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, r)
+		return recorder
+	}
+	decodeError := func(recorder *httptest.ResponseRecorder) *types.Error {
+		var rosettaErr types.Error
+		assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&rosettaErr))
+		return &rosettaErr
+	}
+
+	t.Run("cors", func(t *testing.T) {
+		recorder := serve(http.MethodOptions, "/network/list", "https://example.com", "")
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "https://example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, "Content-Type", recorder.Header().Get("Access-Control-Allow-Headers"))
+		assert.Equal(t, "60", recorder.Header().Get("Access-Control-Max-Age"))
+
+		recorder = serve(http.MethodOptions, "/network/list", "https://other.com", "")
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Empty(t, recorder.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	t.Run("max body size", func(t *testing.T) {
+		recorder := serve(http.MethodPost, "/network/list", "", request)
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		assert.Equal(t, ErrRequestTooLarge, decodeError(recorder))
+
+		recorder = serve(http.MethodPost, "/network/list", "", "{}")
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		// Limits for named routes override
+		// the limit for all routes.
+		recorder = serve(http.MethodPost, "/network/options", "", request)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("declared errors", func(t *testing.T) {
+		recorder := serve(http.MethodPost, "/network/options", "", request)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		var options types.NetworkOptionsResponse
+		assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&options))
+		assert.Equal(t, []*types.Error{
+			modeServicerErr,
+			ErrRequestTooLarge,
+			ErrInternal,
+		}, options.Allow.Errors)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		assert.Equal(t, map[string]bool{
+			"NetworkList":    false,
+			"NetworkOptions": true,
+		}, servicer.deadlines)
+	})
+
+	t.Run("panic recovery", func(t *testing.T) {
+		recorder := serve(http.MethodPost, "/network/status", "", request)
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		assert.Equal(t, &types.Error{
+			Code:    ErrInternal.Code,
+			Message: ErrInternal.Message,
+			Details: map[string]interface{}{"route": "NetworkStatus"},
+		}, decodeError(recorder))
+	})
+}
+
+func TestHardeningErrorCodes(t *testing.T) {
+	router := NewRouterWithOptions(
+		[]Router{NewNetworkAPIController(
+			&hardeningNetworkServicer{deadlines: map[string]bool{}},
+			newTestAsserter(t),
+		)},
+		WithMaxBodySize(8, "NetworkList"),
+		WithPanicRecovery(),
+		WithErrorCodes(&ErrorCodes{RequestTooLarge: 2001, Internal: 2002}),
+	)
+
+	request := types.PrettyPrintStruct(&types.NetworkRequest{
+		NetworkIdentifier: testNetwork,
+	})
+	serve := func(path string) *types.Error {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(
+			http.MethodPost,
+			path,
+			strings.NewReader(request),
+		))
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+
+		var rosettaErr types.Error
+		assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&rosettaErr))
+		return &rosettaErr
+	}
+
+	tooLargeErr := serve("/network/list")
+	assert.Equal(t, int32(2001), tooLargeErr.Code)
+	assert.Equal(t, ErrRequestTooLarge.Message, tooLargeErr.Message)
+
+	internalErr := serve("/network/status")
+	assert.Equal(t, int32(2002), internalErr.Code)
+	assert.Equal(t, ErrInternal.Message, internalErr.Message)
+
+	// A body that cannot be read is not too large.
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(
+		http.MethodPost,
+		"/network/list",
+		iotest.ErrReader(errors.New("connection reset")),
+	))
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+
+	var readErr types.Error
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&readErr))
+	assert.Equal(t, types.Error{
+		Code:    2002,
+		Message: ErrInternal.Message,
+		Details: map[string]interface{}{
+			"route":   "NetworkList",
+			"context": "connection reset",
+		},
+	}, readErr)
+
+	o := &routerOptions{maxBodySizes: map[string]int64{}}
+	WithMaxBodySize(8)(o)
+	assert.Equal(t, []*types.Error{
+		o.codes.resolve(ErrRequestTooLarge),
+		o.codes.resolve(ErrInternal),
+	}, o.declaredErrors())
+}
+
+func TestTimeoutStreamingRoutes(t *testing.T) {
+	var tests = map[string]struct {
+		options []Option
+
+		expectedDeadline bool
+	}{
+		"all routes": {
+			options: []Option{WithTimeout(time.Second)},
+		},
+		"named route": {
+			options: []Option{
+				WithTimeout(time.Second),
+				WithTimeout(time.Minute, "EventsBlocksStream"),
+			},
+			expectedDeadline: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			servicer := &streamEventsServicer{events: testBlockEvents(1)}
+			router := NewRouterWithOptions(
+				[]Router{NewEventsStreamAPIController(servicer, newTestAsserter(t))},
+				test.options...,
+			)
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(
+				http.MethodPost,
+				"/events/blocks/stream",
+				strings.NewReader(types.PrettyPrintStruct(&types.EventsBlocksRequest{
+					NetworkIdentifier: testNetwork,
+					Offset:            types.Int64(0),
+				})),
+			))
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			assert.Equal(t, test.expectedDeadline, servicer.deadline)
+		})
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+const (
+	// HealthPath is the path of the liveness probe
+	// served by NewHealthHandler.
+	HealthPath = "/healthz"
+
+	// ReadyPath is the path of the readiness probe
+	// served by NewHealthHandler.
+	ReadyPath = "/readyz"
+
+	// HealthOK is the status of a passing probe or check.
+	HealthOK = "ok"
+
+	// HealthUnavailable is the status of a failing
+	// probe or check.
+	HealthUnavailable = "unavailable"
+
+	// DefaultHealthTimeout is the maximum duration of
+	// all readiness checks if HealthConfig.Timeout is
+	// not populated.
+	DefaultHealthTimeout = 5 * time.Second
+)
+
+var (
+	// ErrNodeNotSynced is returned by NetworkStatusCheck
+	// when the node reports that it is not synced.
+	ErrNodeNotSynced = errors.New("node is not synced")
+
+	// ErrTipTooOld is returned by NetworkStatusCheck
+	// when the current block is older than the allowed
+	// tip age.
+	ErrTipTooOld = errors.New("current block is too old")
+)
+
+// ReadinessCheck returns an error if a server
+// is not ready to serve requests.
+type ReadinessCheck func(ctx context.Context) error
+
+// HealthConfig configures the probes served
+// by NewHealthHandler.
+type HealthConfig struct {
+	// Checks are run concurrently on each request to
+	// ReadyPath and are reported by name.
+	Checks map[string]ReadinessCheck
+
+	// Timeout is the maximum duration of all checks
+	// (DefaultHealthTimeout is used if it is 0).
+	Timeout time.Duration
+}
+
+// HealthCheckResult is the result of a
+// ReadinessCheck in a HealthStatus.
+type HealthCheckResult struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// HealthStatus is the JSON document returned
+// by HealthPath and ReadyPath.
+type HealthStatus struct {
+	Status string                        `json:"status"`
+	Checks map[string]*HealthCheckResult `json:"checks,omitempty"`
+}
+
+// NewHealthHandler serves HealthPath and ReadyPath alongside
+// the provided router (like one returned by NewRouter). The
+// liveness probe succeeds while the server is running. The
+// readiness probe runs each ReadinessCheck and responds with a
+// 503 if any of them fail. If config is nil, the readiness probe
+// has no checks.
+func NewHealthHandler(router http.Handler, config *HealthConfig) http.Handler {
+	if config == nil {
+		config = &HealthConfig{}
+	}
+
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = DefaultHealthTimeout
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != HealthPath && r.URL.Path != ReadyPath {
+			router.ServeHTTP(w, r)
+			return
+		}
+
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		if r.URL.Path == HealthPath {
+			EncodeJSONResponse(&HealthStatus{Status: HealthOK}, http.StatusOK, w)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		status := runReadinessChecks(ctx, config.Checks)
+		if status.Status != HealthOK {
+			EncodeJSONResponse(status, http.StatusServiceUnavailable, w)
+			return
+		}
+
+		EncodeJSONResponse(status, http.StatusOK, w)
+	})
+}
+
+// runReadinessChecks runs each ReadinessCheck concurrently. A check
+// that has not returned by the time ctx is done fails with the
+// context error.
+func runReadinessChecks(
+	ctx context.Context,
+	checks map[string]ReadinessCheck,
+) *HealthStatus {
+	status := &HealthStatus{
+		Status: HealthOK,
+		Checks: make(map[string]*HealthCheckResult, len(checks)),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check ReadinessCheck) {
+			defer wg.Done()
+
+			result := make(chan error, 1)
+			go func() {
+				result <- check(ctx)
+			}()
+
+			var err error
+			select {
+			case err = <-result:
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				status.Status = HealthUnavailable
+				status.Checks[name] = &HealthCheckResult{
+					Status: HealthUnavailable,
+					Error:  err.Error(),
+				}
+				return
+			}
+
+			status.Checks[name] = &HealthCheckResult{Status: HealthOK}
+		}(name, check)
+	}
+	wg.Wait()
+
+	return status
+}
+
+// NetworkStatusCheck returns a ReadinessCheck that fails if
+// /network/status returns an error or an invalid response, or if
+// the node is not synced.
+// The node is not synced if it populates SyncStatus.Synced with
+// false or if the current block is older than maxTipAge (even if
+// Synced is true, as a stalled node may still report that it is
+// synced). Use a maxTipAge of 0 to only rely on SyncStatus.Synced
+// (for example, for quiescent blockchains).
+func NetworkStatusCheck(
+	servicer NetworkAPIServicer,
+	network *types.NetworkIdentifier,
+	maxTipAge time.Duration,
+) ReadinessCheck {
+	return func(ctx context.Context) error {
+		status, rosettaErr := servicer.NetworkStatus(ctx, &types.NetworkRequest{
+			NetworkIdentifier: network,
+		})
+		if rosettaErr != nil {
+			return fmt.Errorf(
+				"unable to get network status: %d %s",
+				rosettaErr.Code,
+				rosettaErr.Message,
+			)
+		}
+
+		if err := asserter.NetworkStatusResponse(status); err != nil {
+			return fmt.Errorf("network status is invalid: %w", err)
+		}
+
+		if status.SyncStatus != nil && status.SyncStatus.Synced != nil &&
+			!*status.SyncStatus.Synced {
+			return fmt.Errorf(
+				"%w: sync status %s",
+				ErrNodeNotSynced,
+				types.PrintStruct(status.SyncStatus),
+			)
+		}
+
+		if maxTipAge == 0 {
+			return nil
+		}
+
+		tipAge := time.Since(time.UnixMilli(status.CurrentBlockTimestamp))
+		if tipAge > maxTipAge {
+			return fmt.Errorf(
+				"%w: block %d is %s old",
+				ErrTipTooOld,
+				status.CurrentBlockIdentifier.Index,
+				tipAge.Round(time.Second),
+			)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+type healthNetworkServicer struct {
+	modeNetworkServicer
+
+	status *types.NetworkStatusResponse
+}
+
+func (s *healthNetworkServicer) NetworkStatus(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.NetworkStatusResponse, *types.Error) {
+	return s.status, nil
+}
+
+func TestNetworkStatusCheck(t *testing.T) {
+	now := time.Now().UnixMilli()
+	old := time.Now().Add(-time.Hour).UnixMilli()
+	tip := &types.BlockIdentifier{Hash: "block 10", Index: 10}
+	genesis := &types.BlockIdentifier{Hash: "block 0", Index: 0}
+
+	var tests = map[string]struct {
+		servicer  NetworkAPIServicer
+		maxTipAge time.Duration
+
+		expectedErr error
+	}{
+		"recent tip": {
+			servicer: &healthNetworkServicer{status: &types.NetworkStatusResponse{
+				CurrentBlockIdentifier: tip,
+				GenesisBlockIdentifier: genesis,
+				CurrentBlockTimestamp:  now,
+			}},
+			maxTipAge: time.Minute,
+		},
+		"old tip": {
+			servicer: &healthNetworkServicer{status: &types.NetworkStatusResponse{
+				CurrentBlockIdentifier: tip,
+				GenesisBlockIdentifier: genesis,
+				CurrentBlockTimestamp:  old,
+			}},
+			maxTipAge:   time.Minute,
+			expectedErr: ErrTipTooOld,
+		},
+		"old tip without max age": {
+			servicer: &healthNetworkServicer{status: &types.NetworkStatusResponse{
+				CurrentBlockIdentifier: tip,
+				GenesisBlockIdentifier: genesis,
+				CurrentBlockTimestamp:  old,
+			}},
+		},
+		"synced with recent tip": {
+			servicer: &healthNetworkServicer{status: &types.NetworkStatusResponse{
+				CurrentBlockIdentifier: tip,
+				GenesisBlockIdentifier: genesis,
+				CurrentBlockTimestamp:  now,
+				SyncStatus:             &types.SyncStatus{Synced: types.Bool(true)},
+			}},
+			maxTipAge: time.Minute,
+		},
+		"synced with old tip": {
+			servicer: &healthNetworkServicer{status: &types.NetworkStatusResponse{
+				CurrentBlockIdentifier: tip,
+				GenesisBlockIdentifier: genesis,
+				CurrentBlockTimestamp:  old,
+				SyncStatus:             &types.SyncStatus{Synced: types.Bool(true)},
+			}},
+			maxTipAge:   time.Minute,
+			expectedErr: ErrTipTooOld,
+		},
+		"synced with old tip without max age": {
+			servicer: &healthNetworkServicer{status: &types.NetworkStatusResponse{
+				CurrentBlockIdentifier: tip,
+				GenesisBlockIdentifier: genesis,
+				CurrentBlockTimestamp:  old,
+				SyncStatus:             &types.SyncStatus{Synced: types.Bool(true)},
+			}},
+		},
+		"not synced": {
+			servicer: &healthNetworkServicer{status: &types.NetworkStatusResponse{
+				CurrentBlockIdentifier: tip,
+				GenesisBlockIdentifier: genesis,
+				CurrentBlockTimestamp:  now,
+				SyncStatus:             &types.SyncStatus{Synced: types.Bool(false)},
+			}},
+			maxTipAge:   time.Minute,
+			expectedErr: ErrNodeNotSynced,
+		},
+		"nil status": {
+			servicer:    &healthNetworkServicer{},
+			maxTipAge:   time.Minute,
+			expectedErr: asserter.ErrNetworkStatusResponseIsNil,
+		},
+		"missing current block": {
+			servicer: &healthNetworkServicer{status: &types.NetworkStatusResponse{
+				CurrentBlockTimestamp:  now,
+				GenesisBlockIdentifier: genesis,
+			}},
+			maxTipAge:   time.Minute,
+			expectedErr: asserter.ErrBlockIdentifierIsNil,
+		},
+		"status error": {
+			servicer:    &modeNetworkServicer{},
+			maxTipAge:   time.Minute,
+			expectedErr: errors.New("unable to get network status: 1 node unavailable"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := NetworkStatusCheck(test.servicer, testNetwork, test.maxTipAge)(context.Background())
+			switch {
+			case test.expectedErr == nil:
+				assert.NoError(t, err)
+			case errors.Is(test.expectedErr, ErrTipTooOld),
+				errors.Is(test.expectedErr, ErrNodeNotSynced),
+				errors.Is(test.expectedErr, asserter.ErrNetworkStatusResponseIsNil),
+				errors.Is(test.expectedErr, asserter.ErrBlockIdentifierIsNil):
+				assert.ErrorIs(t, err, test.expectedErr)
+			default:
+				assert.EqualError(t, err, test.expectedErr.Error())
+			}
+		})
+	}
+}
+
+func TestHealthHandler(t *testing.T) {
+	router := NewRouter(NewNetworkAPIController(&modeNetworkServicer{}, newTestAsserter(t)))
+	ready := true
+	handler := NewHealthHandler(router, &HealthConfig{
+		Checks: map[string]ReadinessCheck{
+			"node": func(ctx context.Context) error {
+				if !ready {
+					return ErrNodeNotSynced
+				}
+
+				return nil
+			},
+			"storage": func(ctx context.Context) error {
+				return nil
+			},
+		},
+	})
+
+	serve := func(method string, path string) (int, *HealthStatus) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(method, path, nil))
+
+		var status HealthStatus
+		if recorder.Body.Len() > 0 {
+			assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &status))
+		}
+
+		return recorder.Code, &status
+	}
+
+	t.Run("liveness", func(t *testing.T) {
+		code, status := serve(http.MethodGet, HealthPath)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, &HealthStatus{Status: HealthOK}, status)
+	})
+
+	t.Run("ready", func(t *testing.T) {
+		code, status := serve(http.MethodGet, ReadyPath)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, &HealthStatus{
+			Status: HealthOK,
+			Checks: map[string]*HealthCheckResult{
+				"node":    {Status: HealthOK},
+				"storage": {Status: HealthOK},
+			},
+		}, status)
+	})
+
+	t.Run("not ready", func(t *testing.T) {
+		ready = false
+		defer func() { ready = true }()
+
+		code, status := serve(http.MethodGet, ReadyPath)
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, &HealthStatus{
+			Status: HealthUnavailable,
+			Checks: map[string]*HealthCheckResult{
+				"node":    {Status: HealthUnavailable, Error: ErrNodeNotSynced.Error()},
+				"storage": {Status: HealthOK},
+			},
+		}, status)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		code, _ := serve(http.MethodPost, ReadyPath)
+		assert.Equal(t, http.StatusMethodNotAllowed, code)
+	})
+
+	t.Run("router", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(
+			recorder,
+			httptest.NewRequest(http.MethodPost, "/network/list", strings.NewReader("{}")),
+		)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+}
+
+func TestHealthHandlerNilConfig(t *testing.T) {
+	handler := NewHealthHandler(http.NotFoundHandler(), nil)
+
+	for _, path := range []string{HealthPath, ReadyPath} {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+
+		var status HealthStatus
+		assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&status))
+		assert.Equal(t, HealthOK, status.Status)
+	}
+}
+
+func TestHealthHandlerTimeout(t *testing.T) {
+	handler := NewHealthHandler(http.NotFoundHandler(), &HealthConfig{
+		Checks: map[string]ReadinessCheck{
+			"slow": func(ctx context.Context) error {
+				time.Sleep(time.Second)
+				return nil
+			},
+		},
+		Timeout: 10 * time.Millisecond,
+	})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, ReadyPath, nil))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), context.DeadlineExceeded.Error())
+}
@@ -0,0 +1,153 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// bodyRecorder records a request body
+// as it is read.
+type bodyRecorder struct {
+	io.ReadCloser
+	body bytes.Buffer
+}
+
+// Read records the bytes read from the
+// underlying io.ReadCloser.
+func (b *bodyRecorder) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.body.Write(p[:n])
+	return n, err
+}
+
+// responseRecorder records the status code and, for
+// unsuccessful responses, the body written to an
+// http.ResponseWriter.
+type responseRecorder struct {
+	statusRecorder
+	body bytes.Buffer
+}
+
+func newResponseRecorder(w http.ResponseWriter) *responseRecorder {
+	return &responseRecorder{
+		statusRecorder: statusRecorder{ResponseWriter: w, status: http.StatusOK},
+	}
+}
+
+// Write records p (if the response is unsuccessful) before
+// writing it to the underlying http.ResponseWriter.
+func (r *responseRecorder) Write(p []byte) (int, error) {
+	if r.status != http.StatusOK {
+		r.body.Write(p)
+	}
+
+	return r.ResponseWriter.Write(p)
+}
+
+// errorCode returns the code of the *types.Error
+// in an unsuccessful response.
+func (r *responseRecorder) errorCode() (int32, bool) {
+	if r.status == http.StatusOK {
+		return 0, false
+	}
+
+	var rosettaErr types.Error
+	if err := json.Unmarshal(r.body.Bytes(), &rosettaErr); err != nil {
+		return 0, false
+	}
+
+	return rosettaErr.Code, true
+}
+
+// requestNetwork returns the *types.NetworkIdentifier
+// in a request body (if any).
+func requestNetwork(body []byte) *types.NetworkIdentifier {
+	var request struct {
+		NetworkIdentifier *types.NetworkIdentifier `json:"network_identifier"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return nil
+	}
+
+	return request.NetworkIdentifier
+}
+
+// networkAttr returns a slog.Attr describing network.
+func networkAttr(network *types.NetworkIdentifier) slog.Attr {
+	attrs := []any{
+		slog.String("blockchain", network.Blockchain),
+		slog.String("network", network.Network),
+	}
+	if network.SubNetworkIdentifier != nil {
+		attrs = append(attrs, slog.String("sub_network", network.SubNetworkIdentifier.Network))
+	}
+
+	return slog.Group("network_identifier", attrs...)
+}
+
+// WithLogger logs each request with the provided *slog.Logger,
+// recording the route name, network identifier, status code,
+// error code, and latency. Successful requests are logged at
+// slog.LevelInfo and unsuccessful requests at slog.LevelWarn.
+// Requests rejected by other options are also logged (regardless
+// of the order in which options are provided).
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *routerOptions) {
+		o.observers = append(o.observers, func(route Route, next http.Handler) http.Handler {
+			return loggingMiddleware(logger, route, next)
+		})
+	}
+}
+
+func loggingMiddleware(logger *slog.Logger, route Route, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		// The body is recorded as it is read (instead of being
+		// read here) so that size limits are enforced first.
+		body := &bodyRecorder{ReadCloser: r.Body}
+		r.Body = body
+
+		recorder := newResponseRecorder(w)
+		next.ServeHTTP(recorder, r)
+
+		attrs := []slog.Attr{slog.String("route", route.Name)}
+		if network := requestNetwork(body.body.Bytes()); network != nil {
+			attrs = append(attrs, networkAttr(network))
+		}
+
+		attrs = append(attrs, slog.Int("status", recorder.status))
+		if code, ok := recorder.errorCode(); ok {
+			attrs = append(attrs, slog.Int("error_code", int(code)))
+		}
+
+		attrs = append(attrs, slog.Duration("latency", time.Since(start)))
+
+		level := slog.LevelInfo
+		if recorder.status != http.StatusOK {
+			level = slog.LevelWarn
+		}
+
+		logger.LogAttrs(r.Context(), level, "request", attrs...)
+	})
+}
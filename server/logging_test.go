@@ -0,0 +1,127 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+func TestWithLogger(t *testing.T) {
+	var output bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&output, nil))
+
+	router := NewRouterWithOptions(
+		[]Router{NewNetworkAPIController(&modeNetworkServicer{}, newTestAsserter(t))},
+		WithLogger(logger),
+	)
+
+	request := types.PrettyPrintStruct(&types.NetworkRequest{
+		NetworkIdentifier: testNetwork,
+	})
+	for _, path := range []string{"/network/options", "/network/status"} {
+		router.ServeHTTP(
+			httptest.NewRecorder(),
+			httptest.NewRequest(http.MethodPost, path, strings.NewReader(request)),
+		)
+	}
+
+	var entries []map[string]interface{}
+	decoder := json.NewDecoder(&output)
+	for decoder.More() {
+		var entry map[string]interface{}
+		assert.NoError(t, decoder.Decode(&entry))
+		assert.Contains(t, entry, "latency")
+		delete(entry, "latency")
+		delete(entry, "time")
+		entries = append(entries, entry)
+	}
+
+	network := map[string]interface{}{
+		"blockchain": "blockchain",
+		"network":    "network",
+	}
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"level":              "INFO",
+			"msg":                "request",
+			"route":              "NetworkOptions",
+			"network_identifier": network,
+			"status":             float64(http.StatusOK),
+		},
+		{
+			"level":              "WARN",
+			"msg":                "request",
+			"route":              "NetworkStatus",
+			"network_identifier": network,
+			"status":             float64(http.StatusInternalServerError),
+			"error_code":         float64(1),
+		},
+	}, entries)
+}
+
+func TestObservedRejections(t *testing.T) {
+	var output bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&output, nil))
+	metrics := NewMetrics(nil)
+
+	// Observers handle rejected requests even
+	// if they are provided first.
+	router := NewRouterWithOptions(
+		[]Router{NewNetworkAPIController(&modeNetworkServicer{}, newTestAsserter(t))},
+		WithLogger(logger),
+		WithMetrics(metrics),
+		WithOfflineMode(),
+		WithMaxBodySize(8, "NetworkList"),
+	)
+
+	request := types.PrettyPrintStruct(&types.NetworkRequest{
+		NetworkIdentifier: testNetwork,
+	})
+	for _, path := range []string{"/network/status", "/network/list"} {
+		router.ServeHTTP(
+			httptest.NewRecorder(),
+			httptest.NewRequest(http.MethodPost, path, strings.NewReader(request)),
+		)
+	}
+
+	entries := map[string]float64{}
+	decoder := json.NewDecoder(&output)
+	for decoder.More() {
+		var entry map[string]interface{}
+		assert.NoError(t, decoder.Decode(&entry))
+		assert.Equal(t, "WARN", entry["level"])
+		entries[entry["route"].(string)] = entry["error_code"].(float64)
+	}
+	assert.Equal(t, map[string]float64{
+		"NetworkStatus": float64(ErrOfflineMode.Code),
+		"NetworkList":   float64(ErrRequestTooLarge.Code),
+	}, entries)
+
+	recorder := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := recorder.Body.String()
+	assert.Contains(t, body, `mesh_server_errors_total{code="1000",route="NetworkStatus"} 1`)
+	assert.Contains(t, body, `mesh_server_errors_total{code="1001",route="NetworkList"} 1`)
+}
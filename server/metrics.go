@@ -0,0 +1,108 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics records Prometheus metrics for each
+// request (labeled by route name).
+type Metrics struct {
+	registry *prometheus.Registry
+
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+// NewMetrics registers request metrics with the provided
+// *prometheus.Registry. If registry is nil, a new registry
+// is created.
+//
+// The following metrics are recorded:
+//   - mesh_server_request_duration_seconds: a histogram of
+//     request latency, labeled by route and status code.
+//   - mesh_server_errors_total: a counter of errors returned,
+//     labeled by route and types.Error code.
+func NewMetrics(registry *prometheus.Registry) *Metrics {
+	if registry == nil {
+		registry = prometheus.NewRegistry()
+	}
+
+	m := &Metrics{
+		registry: registry,
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "mesh",
+			Subsystem: "server",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of requests by route and status code.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"route", "status"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "mesh",
+			Subsystem: "server",
+			Name:      "errors_total",
+			Help:      "Errors returned by route and error code.",
+		}, []string{"route", "code"}),
+	}
+
+	registry.MustRegister(m.duration, m.errors)
+
+	return m
+}
+
+// Handler returns an http.Handler that exposes the
+// metrics in the registry (usually mounted at /metrics).
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// WithMetrics records each request in *Metrics (including
+// requests rejected by other options, regardless of the order
+// in which options are provided).
+func WithMetrics(metrics *Metrics) Option {
+	return func(o *routerOptions) {
+		o.observers = append(o.observers, metrics.middleware)
+	}
+}
+
+func (m *Metrics) middleware(route Route, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		recorder := newResponseRecorder(w)
+		next.ServeHTTP(recorder, r)
+
+		m.duration.
+			WithLabelValues(route.Name, strconv.Itoa(recorder.status)).
+			Observe(time.Since(start).Seconds())
+
+		if recorder.status == http.StatusOK {
+			return
+		}
+
+		code := "unknown"
+		if errorCode, ok := recorder.errorCode(); ok {
+			code = strconv.FormatInt(int64(errorCode), 10)
+		}
+
+		m.errors.WithLabelValues(route.Name, code).Inc()
+	})
+}
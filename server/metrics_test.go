@@ -0,0 +1,64 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+func TestWithMetrics(t *testing.T) {
+	metrics := NewMetrics(nil)
+	router := NewRouterWithOptions(
+		[]Router{NewNetworkAPIController(&modeNetworkServicer{}, newTestAsserter(t))},
+		WithMetrics(metrics),
+	)
+
+	request := types.PrettyPrintStruct(&types.NetworkRequest{
+		NetworkIdentifier: testNetwork,
+	})
+	for _, path := range []string{"/network/options", "/network/status", "/network/status"} {
+		router.ServeHTTP(
+			httptest.NewRecorder(),
+			httptest.NewRequest(http.MethodPost, path, strings.NewReader(request)),
+		)
+	}
+
+	recorder := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	body, err := io.ReadAll(recorder.Body)
+	assert.NoError(t, err)
+	assert.Contains(
+		t,
+		string(body),
+		`mesh_server_request_duration_seconds_count{route="NetworkOptions",status="200"} 1`,
+	)
+	assert.Contains(
+		t,
+		string(body),
+		`mesh_server_request_duration_seconds_count{route="NetworkStatus",status="500"} 2`,
+	)
+	assert.Contains(t, string(body), `mesh_server_errors_total{code="1",route="NetworkStatus"} 2`)
+	assert.NotContains(t, string(body), `mesh_server_errors_total{code="1",route="NetworkOptions"}`)
+}
@@ -0,0 +1,133 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+const (
+	// ModeMetadataKey is the key in the *types.Version metadata
+	// of /network/options that declares the mode of the server
+	// (OnlineMode or OfflineMode).
+	ModeMetadataKey = "mode"
+
+	// OnlineMode is declared when all routes are served.
+	OnlineMode = "online"
+
+	// OfflineMode is declared when only routes that do not
+	// require a node are served (see WithOfflineMode).
+	OfflineMode = "offline"
+)
+
+// ErrOfflineMode is returned by routes that require a node
+// when the server is in offline mode. It is added to the
+// errors declared in /network/options (its code can be
+// overridden with WithErrorCodes).
+var ErrOfflineMode = &types.Error{
+	Code:      1000,
+	Message:   "endpoint unavailable in offline mode",
+	Retriable: false,
+}
+
+// offlineRoutes are the names of the routes
+// that can be served without a node.
+var offlineRoutes = map[string]struct{}{
+	"NetworkList":            {},
+	"NetworkOptions":         {},
+	"ConstructionDerive":     {},
+	"ConstructionPreprocess": {},
+	"ConstructionPayloads":   {},
+	"ConstructionCombine":    {},
+	"ConstructionParse":      {},
+	"ConstructionHash":       {},
+}
+
+// WithOfflineMode serves only the routes that do not require a
+// node (/network/list, /network/options, and the construction
+// routes other than /construction/metadata and /construction/submit).
+// All other routes return ErrOfflineMode without invoking their
+// servicer.
+func WithOfflineMode() Option {
+	return func(o *routerOptions) {
+		o.offline = true
+	}
+}
+
+// modeMiddleware rejects routes that require a node
+// (in offline mode).
+func (o *routerOptions) modeMiddleware(route Route, next http.Handler) http.Handler {
+	if _, ok := offlineRoutes[route.Name]; o.offline && !ok {
+		offlineErr := o.codes.resolve(ErrOfflineMode)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			newErr := *offlineErr
+			EncodeJSONResponse(&newErr, http.StatusInternalServerError, w)
+		})
+	}
+
+	return next
+}
+
+// networkOptionsMiddleware declares the mode of the server and
+// the errors returned by the router in /network/options. If an
+// error code collides with an error declared by the servicer,
+// /network/options returns an error instead.
+func (o *routerOptions) networkOptionsMiddleware(route Route, next http.Handler) http.Handler {
+	if route.Name != "NetworkOptions" {
+		return next
+	}
+
+	mode := OnlineMode
+	if o.offline {
+		mode = OfflineMode
+	}
+
+	declared := o.declaredErrors()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buffer := newResponseBuffer()
+		next.ServeHTTP(buffer, r)
+
+		var response types.NetworkOptionsResponse
+		if buffer.status != http.StatusOK ||
+			json.Unmarshal(buffer.body.Bytes(), &response) != nil {
+			buffer.writeTo(w)
+			return
+		}
+
+		if response.Version != nil {
+			if response.Version.Metadata == nil {
+				response.Version.Metadata = map[string]interface{}{}
+			}
+
+			response.Version.Metadata[ModeMetadataKey] = mode
+		}
+
+		if response.Allow != nil {
+			if err := declareErrors(response.Allow, declared...); err != nil {
+				log.Printf("%s: %v", route.Name, err)
+				EncodeJSONResponse(&types.Error{
+					Message: err.Error(),
+				}, http.StatusInternalServerError, w)
+				return
+			}
+		}
+
+		EncodeJSONResponse(&response, http.StatusOK, w)
+	})
+}
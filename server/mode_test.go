@@ -0,0 +1,223 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+var (
+	testNetwork = &types.NetworkIdentifier{
+		Blockchain: "blockchain",
+		Network:    "network",
+	}
+
+	modeServicerErr = &types.Error{
+		Code:    1,
+		Message: "node unavailable",
+	}
+)
+
+type modeNetworkServicer struct {
+	// errors are declared in /network/options
+	// (in addition to modeServicerErr).
+	errors []*types.Error
+}
+
+func (s *modeNetworkServicer) NetworkList(
+	ctx context.Context,
+	request *types.MetadataRequest,
+) (*types.NetworkListResponse, *types.Error) {
+	return &types.NetworkListResponse{
+		NetworkIdentifiers: []*types.NetworkIdentifier{testNetwork},
+	}, nil
+}
+
+func (s *modeNetworkServicer) NetworkOptions(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.NetworkOptionsResponse, *types.Error) {
+	return &types.NetworkOptionsResponse{
+		Version: &types.Version{
+			RosettaVersion: "1.4.12",
+			NodeVersion:    "1.0",
+		},
+		Allow: &types.Allow{
+			OperationStatuses: []*types.OperationStatus{
+				{Status: "success", Successful: true},
+			},
+			OperationTypes: []string{"transfer"},
+			Errors:         append([]*types.Error{modeServicerErr}, s.errors...),
+		},
+	}, nil
+}
+
+func (s *modeNetworkServicer) NetworkStatus(
+	ctx context.Context,
+	request *types.NetworkRequest,
+) (*types.NetworkStatusResponse, *types.Error) {
+	return nil, modeServicerErr
+}
+
+func newTestAsserter(t *testing.T) *asserter.Asserter {
+	a, err := asserter.NewServer(
+		[]string{"transfer"},
+		false,
+		[]*types.NetworkIdentifier{testNetwork},
+		nil,
+		false,
+		"",
+	)
+	assert.NoError(t, err)
+
+	return a
+}
+
+func TestOfflineMode(t *testing.T) {
+	a := newTestAsserter(t)
+
+	customOfflineErr := *ErrOfflineMode
+	customOfflineErr.Code = 2000
+
+	var tests = map[string]struct {
+		options        []Option
+		servicerErrors []*types.Error
+
+		expectedMode   string
+		expectedErrors []*types.Error
+		expectedStatus *types.Error
+	}{
+		"online": {
+			expectedMode:   OnlineMode,
+			expectedErrors: []*types.Error{modeServicerErr},
+			expectedStatus: modeServicerErr,
+		},
+		"offline": {
+			options:        []Option{WithOfflineMode()},
+			expectedMode:   OfflineMode,
+			expectedErrors: []*types.Error{modeServicerErr, ErrOfflineMode},
+			expectedStatus: ErrOfflineMode,
+		},
+		"offline already declared": {
+			options:        []Option{WithOfflineMode()},
+			servicerErrors: []*types.Error{ErrOfflineMode},
+			expectedMode:   OfflineMode,
+			expectedErrors: []*types.Error{modeServicerErr, ErrOfflineMode},
+			expectedStatus: ErrOfflineMode,
+		},
+		"offline with custom code": {
+			options: []Option{
+				WithOfflineMode(),
+				WithErrorCodes(&ErrorCodes{OfflineMode: customOfflineErr.Code}),
+			},
+			servicerErrors: []*types.Error{{Code: ErrOfflineMode.Code, Message: "other"}},
+			expectedMode:   OfflineMode,
+			expectedErrors: []*types.Error{
+				modeServicerErr,
+				{Code: ErrOfflineMode.Code, Message: "other"},
+				&customOfflineErr,
+			},
+			expectedStatus: &customOfflineErr,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			router := NewRouterWithOptions(
+				[]Router{NewNetworkAPIController(
+					&modeNetworkServicer{errors: test.servicerErrors},
+					a,
+				)},
+				test.options...,
+			)
+
+			request := types.PrettyPrintStruct(&types.NetworkRequest{
+				NetworkIdentifier: testNetwork,
+			})
+
+			// Offline routes are served in either mode.
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(
+				recorder,
+				httptest.NewRequest(http.MethodPost, "/network/list", strings.NewReader("{}")),
+			)
+			assert.Equal(t, http.StatusOK, recorder.Code)
+
+			// The mode is declared in /network/options.
+			recorder = httptest.NewRecorder()
+			router.ServeHTTP(
+				recorder,
+				httptest.NewRequest(http.MethodPost, "/network/options", strings.NewReader(request)),
+			)
+			assert.Equal(t, http.StatusOK, recorder.Code)
+
+			var options types.NetworkOptionsResponse
+			assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&options))
+			assert.Equal(t, test.expectedMode, options.Version.Metadata[ModeMetadataKey])
+			assert.Equal(t, test.expectedErrors, options.Allow.Errors)
+
+			// Online routes return ErrOfflineMode in offline mode.
+			recorder = httptest.NewRecorder()
+			router.ServeHTTP(
+				recorder,
+				httptest.NewRequest(http.MethodPost, "/network/status", strings.NewReader(request)),
+			)
+			assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+
+			var statusErr types.Error
+			assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&statusErr))
+			assert.Equal(t, test.expectedStatus, &statusErr)
+		})
+	}
+}
+
+func TestOfflineModeCodeCollision(t *testing.T) {
+	router := NewRouterWithOptions(
+		[]Router{NewNetworkAPIController(
+			&modeNetworkServicer{
+				errors: []*types.Error{{Code: ErrOfflineMode.Code, Message: "other"}},
+			},
+			newTestAsserter(t),
+		)},
+		WithOfflineMode(),
+	)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(
+		recorder,
+		httptest.NewRequest(
+			http.MethodPost,
+			"/network/options",
+			strings.NewReader(types.PrettyPrintStruct(&types.NetworkRequest{
+				NetworkIdentifier: testNetwork,
+			})),
+		),
+	)
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+
+	var optionsErr types.Error
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&optionsErr))
+	assert.Contains(t, optionsErr.Message, ErrErrorCodeCollision.Error())
+}
@@ -0,0 +1,164 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// Option configures the http.Handler returned
+// by NewRouterWithOptions.
+type Option func(o *routerOptions)
+
+// routeMiddleware wraps the handler of a Route.
+type routeMiddleware func(route Route, next http.Handler) http.Handler
+
+// allRoutes is the key of limits that apply to
+// all routes in routerOptions.
+const allRoutes = ""
+
+// routerOptions are populated by each Option.
+type routerOptions struct {
+	offline     bool
+	recovery    bool
+	rateLimited bool
+	cached      bool
+	cors        *CORSConfig
+	codes       *ErrorCodes
+
+	// maxBodySizes and timeouts are keyed by
+	// route name (or allRoutes).
+	maxBodySizes map[string]int64
+	timeouts     map[string]time.Duration
+
+	// observers (like logging and metrics) handle requests
+	// before any other middleware, so that they observe
+	// requests rejected by the router.
+	observers []routeMiddleware
+
+	// middleware is applied to each Route in order
+	// (the first middleware handles requests first).
+	middleware []routeMiddleware
+}
+
+// NewRouterWithOptions creates a new router for any number
+// of api routers (like NewRouter) and applies the provided
+// options to each Route.
+func NewRouterWithOptions(routers []Router, options ...Option) http.Handler {
+	config := &routerOptions{
+		maxBodySizes: map[string]int64{},
+		timeouts:     map[string]time.Duration{},
+	}
+	for _, opt := range options {
+		opt(config)
+	}
+
+	middleware := append(
+		[]routeMiddleware{
+			config.modeMiddleware,
+			config.networkOptionsMiddleware,
+			config.limitsMiddleware,
+		},
+		config.middleware...,
+	)
+	if config.recovery {
+		middleware = append([]routeMiddleware{config.recoveryMiddleware}, middleware...)
+	}
+	middleware = append(append([]routeMiddleware{}, config.observers...), middleware...)
+
+	router := mux.NewRouter().StrictSlash(true)
+	for _, api := range routers {
+		for _, route := range api.Routes() {
+			var handler http.Handler = route.HandlerFunc
+			for i := len(middleware) - 1; i >= 0; i-- {
+				handler = middleware[i](route, handler)
+			}
+
+			router.
+				Methods(route.Method).
+				Path(route.Pattern).
+				Name(route.Name).
+				Handler(handler)
+		}
+	}
+
+	if config.cors != nil {
+		return corsMiddleware(config.cors, router)
+	}
+
+	return router
+}
+
+// peekBody reads the body of r and replaces it so
+// that it can be read again by the next http.Handler.
+func peekBody(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// responseBuffer is an http.ResponseWriter that holds
+// a response so that it can be inspected (or modified)
+// before it is written.
+type responseBuffer struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newResponseBuffer() *responseBuffer {
+	return &responseBuffer{header: http.Header{}}
+}
+
+// Header returns the buffered response headers.
+func (b *responseBuffer) Header() http.Header {
+	return b.header
+}
+
+// WriteHeader records the status code of the response.
+func (b *responseBuffer) WriteHeader(status int) {
+	if b.status == 0 {
+		b.status = status
+	}
+}
+
+// Write appends p to the buffered response body.
+func (b *responseBuffer) Write(p []byte) (int, error) {
+	b.WriteHeader(http.StatusOK)
+	return b.body.Write(p)
+}
+
+// writeTo writes the buffered response to w.
+func (b *responseBuffer) writeTo(w http.ResponseWriter) {
+	for key, values := range b.header {
+		w.Header()[key] = values
+	}
+
+	if b.status == 0 {
+		b.status = http.StatusOK
+	}
+
+	w.WriteHeader(b.status)
+	_, _ = w.Write(b.body.Bytes())
+}
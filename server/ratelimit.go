@@ -0,0 +1,255 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+const (
+	// DataRoutes is the route group of all routes
+	// other than the construction routes.
+	DataRoutes = "data"
+
+	// ConstructionRoutes is the route group of
+	// the /construction/* routes.
+	ConstructionRoutes = "construction"
+
+	// RetryAfterKey is the key in the Details of ErrRateLimited
+	// that contains the number of milliseconds to wait before
+	// retrying a request.
+	RetryAfterKey = "retry_after_ms"
+
+	// bucketSweepInterval is how often buckets
+	// that have refilled are removed.
+	bucketSweepInterval = time.Minute
+)
+
+// ErrRateLimited is returned when a client exceeds its
+// quota (see WithRateLimit). The number of milliseconds to
+// wait before retrying is populated in the Details under
+// RetryAfterKey.
+var ErrRateLimited = &types.Error{
+	Code:      1003,
+	Message:   "rate limit exceeded",
+	Retriable: true,
+}
+
+// RouteGroup returns the route group (DataRoutes or
+// ConstructionRoutes) of the named route.
+func RouteGroup(route string) string {
+	if strings.HasPrefix(route, "Construction") {
+		return ConstructionRoutes
+	}
+
+	return DataRoutes
+}
+
+// RateLimit is a token bucket quota that allows Rate
+// requests per second (Rate must be greater than 0), with
+// bursts of up to Burst requests.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimitConfig configures the quotas enforced by WithRateLimit.
+// Quotas are keyed by route group (DataRoutes or ConstructionRoutes)
+// and route groups without a quota are not limited.
+type RateLimitConfig struct {
+	// Limits are the quotas of each client, identified by
+	// API key (if APIKeyHeader is set and populated) or
+	// by IP address.
+	Limits map[string]*RateLimit
+
+	// APIKeyHeader is the request header that
+	// identifies the API key of a client.
+	APIKeyHeader string
+
+	// APIKeyLimits override Limits for specific API keys
+	// (keyed by API key and then by route group).
+	APIKeyLimits map[string]map[string]*RateLimit
+
+	// TrustForwardedFor identifies clients without an API
+	// key by the first address in the X-Forwarded-For header
+	// (when present). This should only be enabled when the
+	// server is behind a proxy that populates the header.
+	TrustForwardedFor bool
+}
+
+// tokenBucket limits the rate of requests from a client.
+// Tokens are added at rate per second, up to burst tokens.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// take removes a token from the bucket (if one is available)
+// or returns how long the caller must wait for one. This must be
+// called while holding the rateLimiter mutex.
+func (b *tokenBucket) take(now time.Time) (time.Duration, bool) {
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, true
+	}
+
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second)), false
+}
+
+// full returns a boolean indicating if the bucket has refilled
+// (and is equivalent to a new bucket). This must be called while
+// holding the rateLimiter mutex.
+func (b *tokenBucket) full(now time.Time) bool {
+	return b.tokens+now.Sub(b.last).Seconds()*b.rate >= b.burst
+}
+
+// rateLimiter holds the token buckets of each
+// client and route group.
+type rateLimiter struct {
+	config *RateLimitConfig
+
+	mutex     sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+// WithRateLimit rejects requests from clients that exceed their
+// quota with ErrRateLimited (and a Retry-After header). Quotas are
+// enforced separately for each route group. ErrRateLimited is
+// added to the errors declared in /network/options.
+func WithRateLimit(config *RateLimitConfig) Option {
+	limiter := &rateLimiter{
+		config:    config,
+		buckets:   map[string]*tokenBucket{},
+		lastSweep: time.Now(),
+	}
+
+	return func(o *routerOptions) {
+		o.rateLimited = true
+		o.middleware = append(o.middleware, func(route Route, next http.Handler) http.Handler {
+			// The codes are resolved once all options are applied.
+			return limiter.middleware(o.codes.resolve(ErrRateLimited), route, next)
+		})
+	}
+}
+
+// client returns the identifier of the client that made r
+// and its quota for group (nil if the client is not limited).
+func (l *rateLimiter) client(r *http.Request, group string) (string, *RateLimit) {
+	if len(l.config.APIKeyHeader) > 0 {
+		if key := r.Header.Get(l.config.APIKeyHeader); len(key) > 0 {
+			if limits, ok := l.config.APIKeyLimits[key]; ok {
+				return "key/" + key, limits[group]
+			}
+
+			return "key/" + key, l.config.Limits[group]
+		}
+	}
+
+	if l.config.TrustForwardedFor {
+		if forwarded := r.Header.Get("X-Forwarded-For"); len(forwarded) > 0 {
+			address, _, _ := strings.Cut(forwarded, ",")
+			return "ip/" + strings.TrimSpace(address), l.config.Limits[group]
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	return "ip/" + host, l.config.Limits[group]
+}
+
+// take removes a token from the bucket of client in group or
+// returns how long the client must wait before retrying.
+func (l *rateLimiter) take(client string, group string, limit *RateLimit) (time.Duration, bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastSweep) > bucketSweepInterval {
+		for key, bucket := range l.buckets {
+			if bucket.full(now) {
+				delete(l.buckets, key)
+			}
+		}
+
+		l.lastSweep = now
+	}
+
+	key := group + "/" + client
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{
+			rate:   limit.Rate,
+			burst:  float64(limit.Burst),
+			tokens: float64(limit.Burst),
+			last:   now,
+		}
+		l.buckets[key] = bucket
+	}
+
+	return bucket.take(now)
+}
+
+// middleware rejects requests from clients that
+// exceed their quota with rateLimitedErr.
+func (l *rateLimiter) middleware(
+	limitedErr *types.Error,
+	route Route,
+	next http.Handler,
+) http.Handler {
+	group := RouteGroup(route.Name)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client, limit := l.client(r, group)
+		if limit == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		retryAfter, ok := l.take(client, group, limit)
+		if ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set(
+			"Retry-After",
+			strconv.FormatInt(int64(math.Ceil(retryAfter.Seconds())), 10),
+		)
+
+		rateLimitedErr := *limitedErr
+		rateLimitedErr.Details = map[string]interface{}{
+			RetryAfterKey: retryAfter.Milliseconds(),
+			"route_group": group,
+		}
+		EncodeJSONResponse(&rateLimitedErr, http.StatusInternalServerError, w)
+	})
+}
@@ -0,0 +1,164 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+func TestRouteGroup(t *testing.T) {
+	assert.Equal(t, ConstructionRoutes, RouteGroup("ConstructionSubmit"))
+	assert.Equal(t, DataRoutes, RouteGroup("Block"))
+	assert.Equal(t, DataRoutes, RouteGroup("NetworkList"))
+}
+
+func TestWithRateLimit(t *testing.T) {
+	router := NewRouterWithOptions(
+		[]Router{NewNetworkAPIController(&modeNetworkServicer{}, newTestAsserter(t))},
+		WithRateLimit(&RateLimitConfig{
+			Limits: map[string]*RateLimit{
+				DataRoutes: {Rate: 1, Burst: 2},
+			},
+			APIKeyHeader: "X-Api-Key",
+			APIKeyLimits: map[string]map[string]*RateLimit{
+				"partner": {
+					DataRoutes: {Rate: 1, Burst: 3},
+				},
+				"unlimited": {},
+			},
+			TrustForwardedFor: true,
+		}),
+	)
+
+	serve := func(headers map[string]string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/network/list", strings.NewReader("{}"))
+		for key, value := range headers {
+			r.Header.Set(key, value)
+		}
+
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, r)
+		return recorder
+	}
+
+	var tests = map[string]struct {
+		headers  map[string]string
+		requests int
+	}{
+		"ip address": {
+			requests: 2,
+		},
+		"forwarded address": {
+			headers:  map[string]string{"X-Forwarded-For": "198.51.100.1, 192.0.2.1"},
+			requests: 2,
+		},
+		"api key": {
+			headers:  map[string]string{"X-Api-Key": "other"},
+			requests: 2,
+		},
+		"api key quota": {
+			headers:  map[string]string{"X-Api-Key": "partner"},
+			requests: 3,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < test.requests; i++ {
+				assert.Equal(t, http.StatusOK, serve(test.headers).Code)
+			}
+
+			recorder := serve(test.headers)
+			assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+			assert.Equal(t, "1", recorder.Header().Get("Retry-After"))
+
+			var rateLimitedErr struct {
+				Code      int32                  `json:"code"`
+				Retriable bool                   `json:"retriable"`
+				Details   map[string]interface{} `json:"details"`
+			}
+			assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&rateLimitedErr))
+			assert.Equal(t, ErrRateLimited.Code, rateLimitedErr.Code)
+			assert.True(t, rateLimitedErr.Retriable)
+			assert.Equal(t, DataRoutes, rateLimitedErr.Details["route_group"])
+			assert.Greater(t, rateLimitedErr.Details[RetryAfterKey], float64(0))
+			assert.LessOrEqual(t, rateLimitedErr.Details[RetryAfterKey], float64(1000))
+		})
+	}
+
+	// Route groups without a quota are not limited.
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusOK, serve(map[string]string{"X-Api-Key": "unlimited"}).Code)
+	}
+}
+
+func TestRateLimitErrorCodes(t *testing.T) {
+	router := NewRouterWithOptions(
+		[]Router{NewNetworkAPIController(&modeNetworkServicer{}, newTestAsserter(t))},
+		WithRateLimit(&RateLimitConfig{
+			Limits: map[string]*RateLimit{
+				DataRoutes: {Rate: 1, Burst: 1},
+			},
+			APIKeyHeader: "X-Api-Key",
+			APIKeyLimits: map[string]map[string]*RateLimit{
+				"unlimited": {},
+			},
+		}),
+		WithErrorCodes(&ErrorCodes{RateLimited: 2003}),
+	)
+
+	expectedErr := *ErrRateLimited
+	expectedErr.Code = 2003
+
+	// ErrRateLimited is declared in /network/options.
+	r := httptest.NewRequest(
+		http.MethodPost,
+		"/network/options",
+		strings.NewReader(types.PrettyPrintStruct(&types.NetworkRequest{
+			NetworkIdentifier: testNetwork,
+		})),
+	)
+	r.Header.Set("X-Api-Key", "unlimited")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, r)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var options types.NetworkOptionsResponse
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&options))
+	assert.Equal(t, []*types.Error{modeServicerErr, &expectedErr}, options.Allow.Errors)
+
+	// Clients that exceed their quota receive the overridden code.
+	for i := 0; i < 2; i++ {
+		recorder = httptest.NewRecorder()
+		router.ServeHTTP(
+			recorder,
+			httptest.NewRequest(http.MethodPost, "/network/list", strings.NewReader("{}")),
+		)
+	}
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+
+	var rateLimitedErr types.Error
+	assert.NoError(t, json.NewDecoder(recorder.Body).Decode(&rateLimitedErr))
+	assert.Equal(t, expectedErr.Code, rateLimitedErr.Code)
+	assert.Equal(t, expectedErr.Message, rateLimitedErr.Message)
+}
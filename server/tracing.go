@@ -0,0 +1,100 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the name of the trace.Tracer used
+// to create spans for requests.
+const tracerName = "github.com/dominant-strategies/mesh-sdk-go/server"
+
+// statusRecorder records the status code written
+// to an http.ResponseWriter.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader records the status code before
+// writing it to the underlying http.ResponseWriter.
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// Unwrap returns the underlying http.ResponseWriter so that
+// an http.ResponseController can flush streamed responses.
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
+
+// routeName returns the name of the Route that handles r.
+// If r has not been matched by a *mux.Router and next is not
+// a *mux.Router, the request path is returned instead.
+func routeName(next http.Handler, r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil && len(route.GetName()) > 0 {
+		return route.GetName()
+	}
+
+	if router, ok := next.(*mux.Router); ok {
+		var match mux.RouteMatch
+		if router.Match(r, &match) && match.Route != nil && len(match.Route.GetName()) > 0 {
+			return match.Route.GetName()
+		}
+	}
+
+	return r.URL.Path
+}
+
+// TracingMiddleware creates a span for each request (named after
+// the Route that handles it) using the provided trace.TracerProvider.
+// If the request contains W3C trace context headers, the span is
+// created as a child of the remote span.
+//
+// To name spans after each Route, next should be the http.Handler
+// returned by NewRouter.
+func TracingMiddleware(tracerProvider trace.TracerProvider, next http.Handler) http.Handler {
+	tracer := tracerProvider.Tracer(tracerName)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := propagation.TraceContext{}.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracer.Start(
+			ctx,
+			routeName(next, r),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", r.Method),
+				attribute.String("url.path", r.URL.Path),
+			),
+		)
+		defer span.End()
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		span.SetAttributes(attribute.Int("http.response.status_code", recorder.status))
+		if recorder.status >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, http.StatusText(recorder.status))
+		}
+	})
+}
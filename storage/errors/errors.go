@@ -200,6 +200,24 @@ var (
 	ErrCannotAccessPrunedData         = errors.New("cannot access pruned data")
 	ErrNothingToPrune                 = errors.New("nothing to prune")
 
+	// ErrTransactionIndexMissing is returned when a search
+	// condition requires a transaction index that is not
+	// enabled in BlockStorage.
+	ErrTransactionIndexMissing = errors.New("transaction index missing")
+
+	// ErrTransactionIndexIncomplete is returned when a search
+	// condition requires a transaction index that was enabled
+	// after blocks were added (and has not been backfilled).
+	ErrTransactionIndexIncomplete = errors.New("transaction index incomplete")
+
+	// ErrSearchConditionUnsupported is returned when a search
+	// condition cannot be answered with transaction indexes.
+	ErrSearchConditionUnsupported = errors.New("search condition unsupported")
+
+	// ErrSearchConditionsMissing is returned when a search
+	// does not provide any conditions.
+	ErrSearchConditionsMissing = errors.New("search conditions missing")
+
 	BlockStorageErrs = []error{
 		ErrHeadBlockNotFound,
 		ErrBlockNotFound,
@@ -216,6 +234,10 @@ var (
 		ErrCannotRemoveOldest,
 		ErrCannotAccessPrunedData,
 		ErrNothingToPrune,
+		ErrTransactionIndexMissing,
+		ErrTransactionIndexIncomplete,
+		ErrSearchConditionUnsupported,
+		ErrSearchConditionsMissing,
 	}
 )
 
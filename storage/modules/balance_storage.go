@@ -1280,6 +1280,46 @@ func (b *BalanceStorage) GetAllAccountCurrency(
 	return accounts, nil
 }
 
+// GetAccountCurrencies returns the currencies of all balances
+// stored for a *types.AccountIdentifier.
+func (b *BalanceStorage) GetAccountCurrencies(
+	ctx context.Context,
+	account *types.AccountIdentifier,
+) ([]*types.Currency, error) {
+	txn := b.db.ReadTransaction(ctx)
+	defer txn.Discard(ctx)
+
+	prefix := []byte(fmt.Sprintf("%s/%s/", accountNamespace, types.Hash(account)))
+	currencies := []*types.Currency{}
+	_, err := txn.Scan(
+		ctx,
+		prefix,
+		prefix,
+		func(k []byte, v []byte) error {
+			var accCurrency types.AccountCurrency
+			// We should not reclaim memory during a scan!!
+			err := b.db.Encoder().DecodeAccountCurrency(v, &accCurrency, false)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to parse balance entry for %s: %w",
+					string(v),
+					err,
+				)
+			}
+
+			currencies = append(currencies, accCurrency.Currency)
+			return nil
+		},
+		false,
+		false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("database scan failed: %w", err)
+	}
+
+	return currencies, nil
+}
+
 // SetBalanceImported sets the balances of a set of addresses by
 // getting their balances from the tip block, and populating the database.
 // This is used when importing prefunded addresses.
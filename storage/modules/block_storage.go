@@ -59,6 +59,10 @@ const (
 	// the root is the destination and the child is the transaction listing the root as a backward
 	// relation
 	backwardRelation = "backwardRelation" // prefix/root/child
+
+	// DefaultStreamChunkSize is the default number of transactions
+	// SeeBlockStream stores in each database transaction.
+	DefaultStreamChunkSize = 1000
 )
 
 type blockTransaction struct {
@@ -147,16 +151,47 @@ type BlockStorage struct {
 
 	workers           []BlockWorker
 	workerConcurrency int
+
+	// indexes are the secondary transaction
+	// indexes maintained by BlockStorage.
+	indexes map[TransactionIndex]struct{}
+
+	// streamChunkSize is the number of transactions
+	// SeeBlockStream stores in each database transaction.
+	streamChunkSize int
 }
 
+// BlockStorageOption is used to overwrite default values in
+// BlockStorage construction.
+type BlockStorageOption func(b *BlockStorage)
+
 // NewBlockStorage returns a new BlockStorage.
 func NewBlockStorage(
 	db database.Database,
 	workerConcurrency int,
+	options ...BlockStorageOption,
 ) *BlockStorage {
-	return &BlockStorage{
+	b := &BlockStorage{
 		db:                db,
 		workerConcurrency: workerConcurrency,
+		indexes:           map[TransactionIndex]struct{}{},
+		streamChunkSize:   DefaultStreamChunkSize,
+	}
+
+	for _, opt := range options {
+		opt(b)
+	}
+
+	return b
+}
+
+// WithStreamChunkSize overrides the number of transactions
+// SeeBlockStream stores in each database transaction.
+func WithStreamChunkSize(size int) BlockStorageOption {
+	return func(b *BlockStorage) {
+		if size > 0 {
+			b.streamChunkSize = size
+		}
 	}
 }
 
@@ -296,6 +331,10 @@ func (b *BlockStorage) pruneBlock(
 			return -1, err
 		}
 
+		if err := b.removeTransactionIndexes(ctx, dbTx, blockIdentifier); err != nil {
+			return -1, fmt.Errorf("unable to remove transaction indexes: %w", err)
+		}
+
 		_, blockKey := getBlockHashKey(blockIdentifier.Hash)
 		if err := dbTx.Set(ctx, blockKey, []byte(""), true); err != nil {
 			return -1, fmt.Errorf("unable to get block hash %s: %w", blockIdentifier.Hash, err)
@@ -318,9 +357,9 @@ func (b *BlockStorage) pruneBlock(
 // Prune removes block and transaction data
 // from all blocks with index <= index. Pruning
 // leaves all keys associated with pruned data
-// but overwrites their data to be empty. If pruning
-// is successful, we return the range of pruned
-// blocks.
+// but overwrites their data to be empty (transaction
+// index entries are removed). If pruning is successful,
+// we return the range of pruned blocks.
 //
 // Prune is not invoked automatically because
 // some applications prefer not to prune any
@@ -743,6 +782,144 @@ func (b *BlockStorage) SeeBlock(
 	return transaction.Commit(ctx)
 }
 
+// BlockStream passes each transaction in a block to handler
+// as it is received and returns the block without transactions
+// (nil if the block was omitted). The fetcher's StreamBlockRetry
+// can be adapted to a BlockStream.
+type BlockStream func(
+	ctx context.Context,
+	handler func(context.Context, *types.BlockIdentifier, *types.Transaction) error,
+) (*types.Block, error)
+
+// SeeBlockStream pre-stores a block like SeeBlock but stores
+// each transaction as it is provided by stream, so the block
+// never needs to be held in memory. Transactions are committed
+// in chunks (see WithStreamChunkSize) and the block is committed
+// last. If stream returns an error, the block is not stored (any
+// transactions already committed are treated like those of a seen
+// block that was never added and are overwritten if the block is
+// streamed again).
+func (b *BlockStorage) SeeBlockStream(
+	ctx context.Context,
+	stream BlockStream,
+) error {
+	var (
+		transaction  database.Transaction
+		checked      bool
+		exists       bool
+		chunk        int
+		identifiers  []*types.TransactionIdentifier
+		identiferSet = map[string]struct{}{}
+	)
+
+	// begin opens a write transaction for the block and, the
+	// first time it is called, checks if the block has already
+	// been stored (in which case we don't need to store its
+	// transactions).
+	begin := func(blockIdentifier *types.BlockIdentifier) error {
+		_, key := getBlockHashKey(blockIdentifier.Hash)
+		transaction = b.db.WriteTransaction(ctx, string(key), true)
+		if checked {
+			return nil
+		}
+
+		var err error
+		exists, _, err = transaction.Get(ctx, key)
+		if err != nil {
+			return fmt.Errorf("unable to get block hash %s: %w", blockIdentifier.Hash, err)
+		}
+
+		checked = true
+		return nil
+	}
+	defer func() {
+		if transaction != nil {
+			transaction.Discard(ctx)
+		}
+	}()
+
+	block, err := stream(ctx, func(
+		ctx context.Context,
+		blockIdentifier *types.BlockIdentifier,
+		txn *types.Transaction,
+	) error {
+		if transaction == nil {
+			if err := begin(blockIdentifier); err != nil {
+				return err
+			}
+		}
+
+		if _, ok := identiferSet[txn.TransactionIdentifier.Hash]; ok {
+			return fmt.Errorf(
+				"duplicate transaction %s found in block index %s: hash %d: %w",
+				txn.TransactionIdentifier.Hash,
+				blockIdentifier.Hash,
+				blockIdentifier.Index,
+				storageErrs.ErrDuplicateTransactionHash,
+			)
+		}
+
+		identiferSet[txn.TransactionIdentifier.Hash] = struct{}{}
+		identifiers = append(identifiers, txn.TransactionIdentifier)
+		if exists {
+			return nil
+		}
+
+		if err := b.storeStreamedTransaction(ctx, transaction, blockIdentifier, txn); err != nil {
+			return fmt.Errorf("unable to store transaction hash: %w", err)
+		}
+
+		// Commit each full chunk so that the database
+		// transaction does not grow with the block.
+		chunk++
+		if chunk < b.streamChunkSize {
+			return nil
+		}
+
+		if err := transaction.Commit(ctx); err != nil {
+			return fmt.Errorf("unable to commit transactions: %w", err)
+		}
+		transaction = nil
+		chunk = 0
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to stream block: %w", err)
+	}
+
+	// There is nothing to store if the block was omitted.
+	if block == nil {
+		return nil
+	}
+
+	if transaction == nil {
+		if err := begin(block.BlockIdentifier); err != nil {
+			return err
+		}
+	}
+
+	// Prepare block for storage
+	copyBlock := *block
+	copyBlock.Transactions = nil
+	blockWithoutTransactions := &types.BlockResponse{
+		Block:             &copyBlock,
+		OtherTransactions: identifiers,
+	}
+
+	// Store block
+	exists, err = b.seeBlock(ctx, transaction, blockWithoutTransactions)
+	if err != nil {
+		return fmt.Errorf("unable to store block: %w", err)
+	}
+
+	if exists {
+		return nil
+	}
+
+	return transaction.Commit(ctx)
+}
+
 // AddBlock stores a block or returns an error.
 func (b *BlockStorage) AddBlock(
 	ctx context.Context,
@@ -761,6 +938,14 @@ func (b *BlockStorage) AddBlock(
 		)
 	}
 
+	if err := b.storeTransactionIndexes(ctx, transaction, block); err != nil {
+		return fmt.Errorf(
+			"unable to store transaction indexes for block %s: %w",
+			types.PrintStruct(block.BlockIdentifier),
+			err,
+		)
+	}
+
 	return b.callWorkersAndCommit(ctx, block, transaction, true)
 }
 
@@ -839,6 +1024,10 @@ func (b *BlockStorage) RemoveBlock(
 		return err
 	}
 
+	if err := b.removeTransactionIndexes(ctx, transaction, blockIdentifier); err != nil {
+		return fmt.Errorf("unable to remove transaction indexes: %w", err)
+	}
+
 	// Delete block
 	if err := b.deleteBlock(ctx, transaction, block); err != nil {
 		return fmt.Errorf("unable to delete block: %w", err)
@@ -1008,6 +1197,33 @@ func (b *BlockStorage) storeTransaction(
 	return storeUniqueKey(ctx, transaction, hashKey, encodedResult, true)
 }
 
+// storeStreamedTransaction stores a transaction like
+// storeTransaction but overwrites any existing value (which
+// may have been committed by a SeeBlockStream that failed).
+// SeeBlockStream detects duplicate transactions itself.
+func (b *BlockStorage) storeStreamedTransaction(
+	ctx context.Context,
+	transaction database.Transaction,
+	blockIdentifier *types.BlockIdentifier,
+	tx *types.Transaction,
+) error {
+	err := b.storeBackwardRelations(ctx, transaction, tx)
+	if err != nil {
+		return fmt.Errorf("unable to store backward relations: %w", err)
+	}
+
+	namespace, hashKey := getTransactionKey(blockIdentifier, tx.TransactionIdentifier)
+	encodedResult, err := b.db.Encoder().Encode(namespace, &blockTransaction{
+		Transaction: tx,
+		BlockIndex:  blockIdentifier.Index,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to encode transaction data: %w", err)
+	}
+
+	return transaction.Set(ctx, hashKey, encodedResult, true)
+}
+
 func (b *BlockStorage) storeBackwardRelations(
 	ctx context.Context,
 	transaction database.Transaction,
@@ -843,6 +843,170 @@ func TestCreateBlockCache(t *testing.T) {
 	})
 }
 
+// streamBlock returns a BlockStream that provides the
+// transactions in block (optionally failing after failAfter
+// transactions).
+func streamBlock(block *types.Block, failAfter int) BlockStream {
+	return func(
+		ctx context.Context,
+		handler func(context.Context, *types.BlockIdentifier, *types.Transaction) error,
+	) (*types.Block, error) {
+		for i, txn := range block.Transactions {
+			if failAfter >= 0 && i == failAfter {
+				return nil, errors.New("stream failed")
+			}
+
+			if err := handler(ctx, block.BlockIdentifier, txn); err != nil {
+				return nil, err
+			}
+		}
+
+		header := *block
+		header.Transactions = nil
+		return &header, nil
+	}
+}
+
+func TestSeeBlockStream(t *testing.T) {
+	ctx := context.Background()
+
+	newDir, err := utils.CreateTempDir()
+	assert.NoError(t, err)
+	defer utils.RemoveTempDir(newDir)
+
+	database, err := newTestBadgerDatabase(ctx, newDir)
+	assert.NoError(t, err)
+	defer database.Close(ctx)
+
+	storage := NewBlockStorage(database, blockWorkerConcurrency)
+
+	streamedBlock := &types.Block{
+		BlockIdentifier:       newBlock.BlockIdentifier,
+		ParentBlockIdentifier: newBlock.ParentBlockIdentifier,
+		Timestamp:             1,
+		Transactions: []*types.Transaction{
+			simpleTransactionFactory("streamTx1", "addr1", "100", &types.Currency{Symbol: "hello"}),
+			simpleTransactionFactory("streamTx2", "addr2", "200", &types.Currency{Symbol: "hello"}),
+			simpleTransactionFactory("streamTx3", "addr3", "300", &types.Currency{Symbol: "hello"}),
+		},
+	}
+
+	t.Run("Stream genesis block", func(t *testing.T) {
+		assert.NoError(t, storage.SeeBlockStream(ctx, streamBlock(genesisBlock, -1)))
+		assert.NoError(t, storage.AddBlock(ctx, genesisBlock))
+	})
+
+	t.Run("Stream fails", func(t *testing.T) {
+		err := storage.SeeBlockStream(ctx, streamBlock(streamedBlock, 2))
+		assert.Error(t, err)
+
+		txn := storage.db.ReadTransaction(ctx)
+		defer txn.Discard(ctx)
+		_, key := getTransactionKey(
+			streamedBlock.BlockIdentifier,
+			streamedBlock.Transactions[0].TransactionIdentifier,
+		)
+		exists, _, err := txn.Get(ctx, key)
+		assert.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("Stream duplicate transaction hash", func(t *testing.T) {
+		duplicateBlock := &types.Block{
+			BlockIdentifier:       streamedBlock.BlockIdentifier,
+			ParentBlockIdentifier: streamedBlock.ParentBlockIdentifier,
+			Timestamp:             1,
+			Transactions: []*types.Transaction{
+				streamedBlock.Transactions[0],
+				streamedBlock.Transactions[0],
+			},
+		}
+
+		err := storage.SeeBlockStream(ctx, streamBlock(duplicateBlock, -1))
+		assert.True(t, errors.Is(err, storageErrs.ErrDuplicateTransactionHash))
+	})
+
+	t.Run("Stream and get block", func(t *testing.T) {
+		assert.NoError(t, storage.SeeBlockStream(ctx, streamBlock(streamedBlock, -1)))
+
+		// Streaming a block that has already been seen is a no-op
+		assert.NoError(t, storage.SeeBlockStream(ctx, streamBlock(streamedBlock, -1)))
+		assert.NoError(t, storage.AddBlock(ctx, streamedBlock))
+
+		block, err := storage.GetBlock(
+			ctx,
+			types.ConstructPartialBlockIdentifier(streamedBlock.BlockIdentifier),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, streamedBlock, block)
+	})
+
+	t.Run("Stream in chunks", func(t *testing.T) {
+		chunkedStorage := NewBlockStorage(
+			database,
+			blockWorkerConcurrency,
+			WithStreamChunkSize(2),
+		)
+		chunkedBlock := &types.Block{
+			BlockIdentifier: &types.BlockIdentifier{
+				Hash:  "chunked",
+				Index: streamedBlock.BlockIdentifier.Index + 1,
+			},
+			ParentBlockIdentifier: streamedBlock.BlockIdentifier,
+			Timestamp:             1,
+			Transactions: []*types.Transaction{
+				simpleTransactionFactory("chunkTx1", "addr1", "100", &types.Currency{Symbol: "hello"}),
+				simpleTransactionFactory("chunkTx2", "addr2", "200", &types.Currency{Symbol: "hello"}),
+				simpleTransactionFactory("chunkTx3", "addr3", "300", &types.Currency{Symbol: "hello"}),
+				simpleTransactionFactory("chunkTx4", "addr4", "400", &types.Currency{Symbol: "hello"}),
+				simpleTransactionFactory("chunkTx5", "addr5", "500", &types.Currency{Symbol: "hello"}),
+			},
+		}
+
+		// The first chunk is committed before the
+		// stream fails, but the block is not stored.
+		err := chunkedStorage.SeeBlockStream(ctx, streamBlock(chunkedBlock, 3))
+		assert.Error(t, err)
+
+		txn := chunkedStorage.db.ReadTransaction(ctx)
+		_, key := getTransactionKey(
+			chunkedBlock.BlockIdentifier,
+			chunkedBlock.Transactions[0].TransactionIdentifier,
+		)
+		exists, _, err := txn.Get(ctx, key)
+		assert.NoError(t, err)
+		assert.True(t, exists)
+
+		_, key = getBlockHashKey(chunkedBlock.BlockIdentifier.Hash)
+		exists, _, err = txn.Get(ctx, key)
+		assert.NoError(t, err)
+		assert.False(t, exists)
+		txn.Discard(ctx)
+
+		// Streaming the block again overwrites the
+		// committed transactions.
+		assert.NoError(t, chunkedStorage.SeeBlockStream(ctx, streamBlock(chunkedBlock, -1)))
+		assert.NoError(t, chunkedStorage.AddBlock(ctx, chunkedBlock))
+
+		block, err := chunkedStorage.GetBlock(
+			ctx,
+			types.ConstructPartialBlockIdentifier(chunkedBlock.BlockIdentifier),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, chunkedBlock, block)
+	})
+
+	t.Run("Stream omitted block", func(t *testing.T) {
+		err := storage.SeeBlockStream(ctx, func(
+			ctx context.Context,
+			handler func(context.Context, *types.BlockIdentifier, *types.Transaction) error,
+		) (*types.Block, error) {
+			return nil, nil
+		})
+		assert.NoError(t, err)
+	})
+}
+
 func TestAtTip(t *testing.T) {
 	ctx := context.Background()
 
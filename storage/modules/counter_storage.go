@@ -72,6 +72,10 @@ const (
 	// failures that were not exempt.
 	FailedReconciliationCounter = "failed_reconciliations"
 
+	// ToleratedReconciliationCounter is the number of reconciliation
+	// failures that were within the configured tolerance of a currency.
+	ToleratedReconciliationCounter = "tolerated_reconciliations"
+
 	// SkippedReconciliationsCounter is the number of reconciliation
 	// attempts that were skipped. This typically occurs because an
 	// account balance has been updated since being marked for reconciliation
@@ -0,0 +1,248 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"context"
+
+	"github.com/dominant-strategies/mesh-sdk-go/parser"
+	"github.com/dominant-strategies/mesh-sdk-go/reconciler"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+var (
+	_ reconciler.Handler          = (*ReconciliationJournalHandler)(nil)
+	_ reconciler.ToleranceHandler = (*ReconciliationJournalHandler)(nil)
+)
+
+// ReconciliationJournalHandler is a reconciler.Handler that records
+// each reconciliation in a *ReconciliationStorage before invoking
+// the wrapped reconciler.Handler (if not nil). Entries are recorded
+// first so that a reconciliation is journaled even if the wrapped
+// handler returns an error (to halt the reconciler).
+type ReconciliationJournalHandler struct {
+	storage *ReconciliationStorage
+	handler reconciler.Handler
+}
+
+// NewReconciliationJournalHandler returns a new
+// *ReconciliationJournalHandler.
+func NewReconciliationJournalHandler(
+	storage *ReconciliationStorage,
+	handler reconciler.Handler,
+) *ReconciliationJournalHandler {
+	return &ReconciliationJournalHandler{
+		storage: storage,
+		handler: handler,
+	}
+}
+
+// ReconciliationFailed records a ReconciliationFailure entry.
+func (h *ReconciliationJournalHandler) ReconciliationFailed(
+	ctx context.Context,
+	reconciliationType string,
+	account *types.AccountIdentifier,
+	currency *types.Currency,
+	computedBalance string,
+	liveBalance string,
+	block *types.BlockIdentifier,
+) error {
+	if err := h.storage.Store(ctx, &ReconciliationEntry{
+		Account:         account,
+		Currency:        currency,
+		Block:           block,
+		ComputedBalance: computedBalance,
+		LiveBalance:     liveBalance,
+		Type:            reconciliationType,
+		Result:          ReconciliationFailure,
+	}); err != nil {
+		return err
+	}
+
+	if h.handler == nil {
+		return nil
+	}
+
+	return h.handler.ReconciliationFailed(
+		ctx,
+		reconciliationType,
+		account,
+		currency,
+		computedBalance,
+		liveBalance,
+		block,
+	)
+}
+
+// ReconciliationSucceeded records a ReconciliationSuccess entry.
+func (h *ReconciliationJournalHandler) ReconciliationSucceeded(
+	ctx context.Context,
+	reconciliationType string,
+	account *types.AccountIdentifier,
+	currency *types.Currency,
+	balance string,
+	block *types.BlockIdentifier,
+) error {
+	if err := h.storage.Store(ctx, &ReconciliationEntry{
+		Account:         account,
+		Currency:        currency,
+		Block:           block,
+		ComputedBalance: balance,
+		LiveBalance:     balance,
+		Type:            reconciliationType,
+		Result:          ReconciliationSuccess,
+	}); err != nil {
+		return err
+	}
+
+	if h.handler == nil {
+		return nil
+	}
+
+	return h.handler.ReconciliationSucceeded(
+		ctx,
+		reconciliationType,
+		account,
+		currency,
+		balance,
+		block,
+	)
+}
+
+// ReconciliationExempt records a ReconciliationExempt entry.
+func (h *ReconciliationJournalHandler) ReconciliationExempt(
+	ctx context.Context,
+	reconciliationType string,
+	account *types.AccountIdentifier,
+	currency *types.Currency,
+	computedBalance string,
+	liveBalance string,
+	block *types.BlockIdentifier,
+	exemption *types.BalanceExemption,
+) error {
+	if err := h.storage.Store(ctx, &ReconciliationEntry{
+		Account:         account,
+		Currency:        currency,
+		Block:           block,
+		ComputedBalance: computedBalance,
+		LiveBalance:     liveBalance,
+		Type:            reconciliationType,
+		Result:          ReconciliationExempt,
+	}); err != nil {
+		return err
+	}
+
+	if h.handler == nil {
+		return nil
+	}
+
+	return h.handler.ReconciliationExempt(
+		ctx,
+		reconciliationType,
+		account,
+		currency,
+		computedBalance,
+		liveBalance,
+		block,
+		exemption,
+	)
+}
+
+// ReconciliationSkipped records a ReconciliationSkipped entry
+// (with the cause).
+func (h *ReconciliationJournalHandler) ReconciliationSkipped(
+	ctx context.Context,
+	reconciliationType string,
+	account *types.AccountIdentifier,
+	currency *types.Currency,
+	cause string,
+) error {
+	if err := h.storage.Store(ctx, &ReconciliationEntry{
+		Account:  account,
+		Currency: currency,
+		Type:     reconciliationType,
+		Result:   ReconciliationSkipped,
+		Cause:    cause,
+	}); err != nil {
+		return err
+	}
+
+	if h.handler == nil {
+		return nil
+	}
+
+	return h.handler.ReconciliationSkipped(
+		ctx,
+		reconciliationType,
+		account,
+		currency,
+		cause,
+	)
+}
+
+// ReconciliationWithinTolerance records a
+// ReconciliationWithinTolerance entry. Like the
+// reconciler, it invokes ReconciliationSucceeded
+// if the wrapped handler is not a
+// reconciler.ToleranceHandler.
+func (h *ReconciliationJournalHandler) ReconciliationWithinTolerance(
+	ctx context.Context,
+	reconciliationType string,
+	account *types.AccountIdentifier,
+	currency *types.Currency,
+	computedBalance string,
+	liveBalance string,
+	block *types.BlockIdentifier,
+	tolerance *parser.BalanceTolerance,
+) error {
+	if err := h.storage.Store(ctx, &ReconciliationEntry{
+		Account:         account,
+		Currency:        currency,
+		Block:           block,
+		ComputedBalance: computedBalance,
+		LiveBalance:     liveBalance,
+		Type:            reconciliationType,
+		Result:          ReconciliationWithinTolerance,
+	}); err != nil {
+		return err
+	}
+
+	if h.handler == nil {
+		return nil
+	}
+
+	toleranceHandler, ok := h.handler.(reconciler.ToleranceHandler)
+	if !ok {
+		return h.handler.ReconciliationSucceeded(
+			ctx,
+			reconciliationType,
+			account,
+			currency,
+			liveBalance,
+			block,
+		)
+	}
+
+	return toleranceHandler.ReconciliationWithinTolerance(
+		ctx,
+		reconciliationType,
+		account,
+		currency,
+		computedBalance,
+		liveBalance,
+		block,
+		tolerance,
+	)
+}
@@ -0,0 +1,206 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mocks "github.com/dominant-strategies/mesh-sdk-go/mocks/reconciler"
+	mockDatabase "github.com/dominant-strategies/mesh-sdk-go/mocks/storage/database"
+	"github.com/dominant-strategies/mesh-sdk-go/parser"
+	"github.com/dominant-strategies/mesh-sdk-go/reconciler"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+	"github.com/dominant-strategies/mesh-sdk-go/utils"
+)
+
+func TestReconciliationJournalHandler(t *testing.T) {
+	var (
+		block = &types.BlockIdentifier{
+			Hash:  "block 1",
+			Index: 1,
+		}
+		currency = &types.Currency{
+			Symbol:   "BTC",
+			Decimals: 8,
+		}
+		matched   = &types.AccountIdentifier{Address: "addr 1"}
+		tolerated = &types.AccountIdentifier{Address: "addr 2"}
+		tolerance = &parser.BalanceTolerance{
+			Currency: currency,
+			Absolute: "5",
+		}
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newDir, err := utils.CreateTempDir()
+	assert.NoError(t, err)
+	defer utils.RemoveTempDir(newDir)
+
+	database, err := newTestBadgerDatabase(ctx, newDir)
+	assert.NoError(t, err)
+	defer database.Close(ctx)
+
+	storage := NewReconciliationStorage(database)
+	mockHelper := &mocks.Helper{}
+	mockHandler := &mocks.Handler{}
+	r := reconciler.New(
+		mockHelper,
+		NewReconciliationJournalHandler(storage, mockHandler),
+		parser.New(nil, nil, nil),
+		reconciler.WithActiveConcurrency(1),
+		reconciler.WithInactiveConcurrency(0),
+		reconciler.WithLookupBalanceByBlock(),
+		reconciler.WithBalanceTolerances([]*parser.BalanceTolerance{tolerance}),
+	)
+
+	balances := map[*types.AccountIdentifier][2]string{
+		matched:   {"100", "100"},
+		tolerated: {"105", "100"},
+	}
+	for account, balance := range balances {
+		mtxn := &mockDatabase.Transaction{}
+		mtxn.On("Discard", mock.Anything).Once()
+		mockHelper.On("DatabaseTransaction", mock.Anything).Return(mtxn).Once()
+		mockHelper.On("CurrentBlock", mock.Anything, mtxn).Return(block, nil).Once()
+		mockHelper.On(
+			"LiveBalance",
+			mock.Anything,
+			account,
+			currency,
+			block.Index,
+		).Return(
+			&types.Amount{Value: balance[0], Currency: currency},
+			block,
+			nil,
+		).Once()
+		mockHelper.On("CanonicalBlock", mock.Anything, mtxn, block).Return(true, nil).Once()
+		mockHelper.On(
+			"ComputedBalance",
+			mock.Anything,
+			mtxn,
+			account,
+			currency,
+			block.Index,
+		).Return(
+			&types.Amount{Value: balance[1], Currency: currency},
+			nil,
+		).Once()
+
+		// The wrapped handler does not implement
+		// reconciler.ToleranceHandler, so tolerated
+		// reconciliations are reported as successful.
+		mockHandler.On(
+			"ReconciliationSucceeded",
+			mock.Anything,
+			reconciler.ActiveReconciliation,
+			account,
+			currency,
+			balance[0],
+			block,
+		).Return(nil).Once()
+	}
+
+	go func() {
+		_ = r.Reconcile(ctx)
+	}()
+
+	changes := []*parser.BalanceChange{}
+	for account := range balances {
+		changes = append(changes, &parser.BalanceChange{
+			Account:    account,
+			Currency:   currency,
+			Difference: "100",
+			Block:      block,
+		})
+	}
+	assert.NoError(t, r.QueueChanges(ctx, block, changes))
+
+	assert.Eventually(t, func() bool {
+		entries, err := storage.Get(ctx, nil)
+		return err == nil && len(entries) == len(balances)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	entries, err := storage.Get(ctx, &ReconciliationQuery{Account: matched})
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, ReconciliationSuccess, entries[0].Result)
+	assert.Equal(t, reconciler.ActiveReconciliation, entries[0].Type)
+	assert.Equal(t, block, entries[0].Block)
+
+	entries, err = storage.Get(ctx, &ReconciliationQuery{Account: tolerated})
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, ReconciliationWithinTolerance, entries[0].Result)
+	assert.Equal(t, "100", entries[0].ComputedBalance)
+	assert.Equal(t, "105", entries[0].LiveBalance)
+
+	mockHelper.AssertExpectations(t)
+	mockHandler.AssertExpectations(t)
+
+	// Entries are recorded without a
+	// wrapped handler.
+	handler := NewReconciliationJournalHandler(storage, nil)
+	assert.NoError(t, handler.ReconciliationFailed(
+		ctx,
+		reconciler.InactiveReconciliation,
+		matched,
+		currency,
+		"100",
+		"110",
+		block,
+	))
+	assert.NoError(t, handler.ReconciliationExempt(
+		ctx,
+		reconciler.InactiveReconciliation,
+		matched,
+		currency,
+		"100",
+		"110",
+		block,
+		&types.BalanceExemption{Currency: currency},
+	))
+	assert.NoError(t, handler.ReconciliationSkipped(
+		ctx,
+		reconciler.InactiveReconciliation,
+		matched,
+		currency,
+		reconciler.HeadBehind,
+	))
+
+	entries, err = storage.Get(ctx, &ReconciliationQuery{Account: matched})
+	assert.NoError(t, err)
+	results := []string{}
+	for _, entry := range entries {
+		results = append(results, entry.Result)
+	}
+	assert.ElementsMatch(t, []string{
+		ReconciliationSuccess,
+		ReconciliationFailure,
+		ReconciliationExempt,
+		ReconciliationSkipped,
+	}, results)
+
+	entries, err = storage.Get(ctx, &ReconciliationQuery{Result: ReconciliationSkipped})
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, reconciler.HeadBehind, entries[0].Cause)
+}
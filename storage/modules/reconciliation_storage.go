@@ -0,0 +1,402 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/dominant-strategies/mesh-sdk-go/storage/database"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+	"github.com/dominant-strategies/mesh-sdk-go/utils"
+)
+
+const (
+	// reconciliationJournalNamespace is prepended to any stored
+	// reconciliation journal entry.
+	reconciliationJournalNamespace = "recjournal"
+)
+
+const (
+	// ReconciliationSuccess is the result of a reconciliation
+	// where the computed and live balance matched.
+	ReconciliationSuccess = "SUCCESS"
+
+	// ReconciliationFailure is the result of a reconciliation
+	// where the computed and live balance did not match.
+	ReconciliationFailure = "FAILURE"
+
+	// ReconciliationExempt is the result of a reconciliation
+	// where the mismatch matched a *types.BalanceExemption.
+	ReconciliationExempt = "EXEMPT"
+
+	// ReconciliationWithinTolerance is the result of a reconciliation
+	// where the mismatch was within the configured tolerance.
+	ReconciliationWithinTolerance = "WITHIN_TOLERANCE"
+
+	// ReconciliationSkipped is the result of a reconciliation
+	// that could not be performed.
+	ReconciliationSkipped = "SKIPPED"
+)
+
+var (
+	errReconciliationRangeEnd = errors.New("end of reconciliation range")
+
+	// reconciliationCSVHeader is the first row written
+	// by ExportCSV.
+	reconciliationCSVHeader = []string{
+		"timestamp",
+		"type",
+		"result",
+		"account",
+		"currency",
+		"block_index",
+		"block_hash",
+		"computed_balance",
+		"live_balance",
+		"cause",
+	}
+)
+
+// ReconciliationEntry is a single record in the
+// reconciliation journal.
+type ReconciliationEntry struct {
+	Account         *types.AccountIdentifier `json:"account"`
+	Currency        *types.Currency          `json:"currency"`
+	Block           *types.BlockIdentifier   `json:"block,omitempty"`
+	ComputedBalance string                   `json:"computed_balance,omitempty"`
+	LiveBalance     string                   `json:"live_balance,omitempty"`
+
+	// Type is the reconciliation type (i.e. ACTIVE or INACTIVE).
+	Type string `json:"type"`
+
+	// Result is one of the Reconciliation* result constants.
+	Result string `json:"result"`
+
+	// Cause is populated when a reconciliation is skipped.
+	Cause string `json:"cause,omitempty"`
+
+	// Timestamp is the time (in milliseconds) the
+	// reconciliation was recorded.
+	Timestamp int64 `json:"timestamp"`
+}
+
+// ReconciliationQuery restricts the entries returned
+// from the reconciliation journal. Any unset field is
+// not used to filter entries.
+type ReconciliationQuery struct {
+	// StartTime and EndTime are inclusive bounds
+	// (in milliseconds) on entry timestamps.
+	StartTime *int64
+	EndTime   *int64
+
+	// StartIndex and EndIndex are inclusive bounds
+	// on the index of the block an entry was
+	// reconciled at.
+	StartIndex *int64
+	EndIndex   *int64
+
+	Account  *types.AccountIdentifier
+	Currency *types.Currency
+	Result   string
+
+	// Limit is the maximum number of entries
+	// to return (0 is unlimited).
+	Limit int
+}
+
+// matches returns a boolean indicating if
+// a *ReconciliationEntry satisfies all non-time
+// restrictions of the *ReconciliationQuery.
+func (q *ReconciliationQuery) matches(entry *ReconciliationEntry) bool {
+	if q.StartIndex != nil || q.EndIndex != nil {
+		if entry.Block == nil {
+			return false
+		}
+
+		if q.StartIndex != nil && entry.Block.Index < *q.StartIndex {
+			return false
+		}
+
+		if q.EndIndex != nil && entry.Block.Index > *q.EndIndex {
+			return false
+		}
+	}
+
+	if q.Account != nil && types.Hash(q.Account) != types.Hash(entry.Account) {
+		return false
+	}
+
+	if q.Currency != nil && types.Hash(q.Currency) != types.Hash(entry.Currency) {
+		return false
+	}
+
+	if len(q.Result) > 0 && q.Result != entry.Result {
+		return false
+	}
+
+	return true
+}
+
+func getReconciliationTimePrefix(timestamp int64) []byte {
+	return []byte(
+		fmt.Sprintf("%s/%020d", reconciliationJournalNamespace, timestamp),
+	)
+}
+
+func getReconciliationEntryKey(timestamp int64, id string) []byte {
+	return []byte(
+		fmt.Sprintf("%s/%s", getReconciliationTimePrefix(timestamp), id),
+	)
+}
+
+// ReconciliationStorage implements a reconciliation journal
+// on top of a database.Database and database.Transaction interface.
+// Entries are ordered by the time they were recorded so that
+// they can be exported as audit evidence.
+type ReconciliationStorage struct {
+	db database.Database
+}
+
+// NewReconciliationStorage returns a new ReconciliationStorage.
+func NewReconciliationStorage(
+	db database.Database,
+) *ReconciliationStorage {
+	return &ReconciliationStorage{
+		db: db,
+	}
+}
+
+// StoreTransactional stores a *ReconciliationEntry in a
+// database.Transaction. If the entry Timestamp is not
+// populated, it is set to the current time.
+func (r *ReconciliationStorage) StoreTransactional(
+	ctx context.Context,
+	dbTx database.Transaction,
+	entry *ReconciliationEntry,
+) error {
+	if entry.Timestamp == 0 {
+		entry.Timestamp = utils.Milliseconds()
+	}
+
+	val, err := r.db.Encoder().Encode("", entry)
+	if err != nil {
+		return fmt.Errorf("unable to encode reconciliation entry: %w", err)
+	}
+
+	key := getReconciliationEntryKey(entry.Timestamp, uuid.NewString())
+	if err := storeUniqueKey(ctx, dbTx, key, val, true); err != nil {
+		return fmt.Errorf("unable to store reconciliation entry: %w", err)
+	}
+
+	return nil
+}
+
+// Store saves a *ReconciliationEntry to the journal.
+func (r *ReconciliationStorage) Store(
+	ctx context.Context,
+	entry *ReconciliationEntry,
+) error {
+	dbTx := r.db.WriteTransaction(ctx, reconciliationJournalNamespace, false)
+	defer dbTx.Discard(ctx)
+
+	if err := r.StoreTransactional(ctx, dbTx, entry); err != nil {
+		return err
+	}
+
+	if err := dbTx.Commit(ctx); err != nil {
+		return fmt.Errorf("unable to commit reconciliation entry: %w", err)
+	}
+
+	return nil
+}
+
+// ScanTransactional invokes worker on each *ReconciliationEntry
+// matching the *ReconciliationQuery (from oldest to newest)
+// in a database.Transaction.
+func (r *ReconciliationStorage) ScanTransactional(
+	ctx context.Context,
+	dbTx database.Transaction,
+	query *ReconciliationQuery,
+	worker func(*ReconciliationEntry) error,
+) error {
+	if query == nil {
+		query = &ReconciliationQuery{}
+	}
+
+	seekStart := []byte(reconciliationJournalNamespace)
+	if query.StartTime != nil {
+		seekStart = getReconciliationTimePrefix(*query.StartTime)
+	}
+
+	found := 0
+	_, err := dbTx.Scan(
+		ctx,
+		[]byte(reconciliationJournalNamespace),
+		seekStart,
+		func(k []byte, v []byte) error {
+			var entry ReconciliationEntry
+			// We should not reclaim memory during a scan!!
+			if err := r.db.Encoder().Decode("", v, &entry, false); err != nil {
+				return fmt.Errorf("unable to decode reconciliation entry: %w", err)
+			}
+
+			if query.EndTime != nil && entry.Timestamp > *query.EndTime {
+				return errReconciliationRangeEnd
+			}
+
+			if !query.matches(&entry) {
+				return nil
+			}
+
+			if err := worker(&entry); err != nil {
+				return err
+			}
+
+			found++
+			if query.Limit > 0 && found >= query.Limit {
+				return errReconciliationRangeEnd
+			}
+
+			return nil
+		},
+		false,
+		false,
+	)
+	if err != nil && !errors.Is(err, errReconciliationRangeEnd) {
+		return fmt.Errorf("database scan failed: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns all *ReconciliationEntry matching
+// the *ReconciliationQuery (from oldest to newest).
+func (r *ReconciliationStorage) Get(
+	ctx context.Context,
+	query *ReconciliationQuery,
+) ([]*ReconciliationEntry, error) {
+	dbTx := r.db.ReadTransaction(ctx)
+	defer dbTx.Discard(ctx)
+
+	entries := []*ReconciliationEntry{}
+	err := r.ScanTransactional(
+		ctx,
+		dbTx,
+		query,
+		func(entry *ReconciliationEntry) error {
+			entries = append(entries, entry)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get reconciliation entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// ExportJSONL writes all *ReconciliationEntry matching
+// the *ReconciliationQuery to w, one JSON object per line.
+func (r *ReconciliationStorage) ExportJSONL(
+	ctx context.Context,
+	w io.Writer,
+	query *ReconciliationQuery,
+) error {
+	dbTx := r.db.ReadTransaction(ctx)
+	defer dbTx.Discard(ctx)
+
+	enc := json.NewEncoder(w)
+	err := r.ScanTransactional(
+		ctx,
+		dbTx,
+		query,
+		func(entry *ReconciliationEntry) error {
+			return enc.Encode(entry)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to export reconciliation entries: %w", err)
+	}
+
+	return nil
+}
+
+// ExportCSV writes all *ReconciliationEntry matching
+// the *ReconciliationQuery to w as CSV (with a header row).
+// The account and currency columns are JSON-encoded.
+func (r *ReconciliationStorage) ExportCSV(
+	ctx context.Context,
+	w io.Writer,
+	query *ReconciliationQuery,
+) error {
+	dbTx := r.db.ReadTransaction(ctx)
+	defer dbTx.Discard(ctx)
+
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(reconciliationCSVHeader); err != nil {
+		return fmt.Errorf("unable to write csv header: %w", err)
+	}
+
+	err := r.ScanTransactional(
+		ctx,
+		dbTx,
+		query,
+		func(entry *ReconciliationEntry) error {
+			return csvWriter.Write(reconciliationCSVRecord(entry))
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to export reconciliation entries: %w", err)
+	}
+
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return fmt.Errorf("unable to flush csv: %w", err)
+	}
+
+	return nil
+}
+
+// reconciliationCSVRecord converts a *ReconciliationEntry
+// into a row matching reconciliationCSVHeader.
+func reconciliationCSVRecord(entry *ReconciliationEntry) []string {
+	blockIndex := ""
+	blockHash := ""
+	if entry.Block != nil {
+		blockIndex = strconv.FormatInt(entry.Block.Index, 10)
+		blockHash = entry.Block.Hash
+	}
+
+	return []string{
+		strconv.FormatInt(entry.Timestamp, 10),
+		entry.Type,
+		entry.Result,
+		types.PrintStruct(entry.Account),
+		types.PrintStruct(entry.Currency),
+		blockIndex,
+		blockHash,
+		entry.ComputedBalance,
+		entry.LiveBalance,
+		entry.Cause,
+	}
+}
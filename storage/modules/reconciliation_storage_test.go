@@ -0,0 +1,190 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+	"github.com/dominant-strategies/mesh-sdk-go/utils"
+)
+
+func TestReconciliationStorage(t *testing.T) {
+	ctx := context.Background()
+
+	newDir, err := utils.CreateTempDir()
+	assert.NoError(t, err)
+	defer utils.RemoveTempDir(newDir)
+
+	database, err := newTestBadgerDatabase(ctx, newDir)
+	assert.NoError(t, err)
+	defer database.Close(ctx)
+
+	r := NewReconciliationStorage(database)
+
+	var (
+		account1 = &types.AccountIdentifier{Address: "addr 1"}
+		account2 = &types.AccountIdentifier{Address: "addr 2"}
+		currency = &types.Currency{Symbol: "BTC", Decimals: 8}
+
+		success = &ReconciliationEntry{
+			Account:         account1,
+			Currency:        currency,
+			Block:           &types.BlockIdentifier{Index: 1, Hash: "block 1"},
+			ComputedBalance: "100",
+			LiveBalance:     "100",
+			Type:            "ACTIVE",
+			Result:          ReconciliationSuccess,
+			Timestamp:       1000,
+		}
+		failure = &ReconciliationEntry{
+			Account:         account2,
+			Currency:        currency,
+			Block:           &types.BlockIdentifier{Index: 2, Hash: "block 2"},
+			ComputedBalance: "100",
+			LiveBalance:     "105",
+			Type:            "INACTIVE",
+			Result:          ReconciliationFailure,
+			Timestamp:       2000,
+		}
+		skipped = &ReconciliationEntry{
+			Account:   account1,
+			Currency:  currency,
+			Type:      "ACTIVE",
+			Result:    ReconciliationSkipped,
+			Cause:     "HEAD_BEHIND",
+			Timestamp: 3000,
+		}
+	)
+
+	t.Run("empty journal", func(t *testing.T) {
+		entries, err := r.Get(ctx, nil)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 0)
+	})
+
+	t.Run("store entries", func(t *testing.T) {
+		// Store out of order to ensure entries are
+		// returned by timestamp.
+		assert.NoError(t, r.Store(ctx, skipped))
+		assert.NoError(t, r.Store(ctx, failure))
+		assert.NoError(t, r.Store(ctx, success))
+
+		entries, err := r.Get(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []*ReconciliationEntry{success, failure, skipped}, entries)
+	})
+
+	t.Run("populate timestamp", func(t *testing.T) {
+		newDir, err := utils.CreateTempDir()
+		assert.NoError(t, err)
+		defer utils.RemoveTempDir(newDir)
+
+		database, err := newTestBadgerDatabase(ctx, newDir)
+		assert.NoError(t, err)
+		defer database.Close(ctx)
+
+		entry := &ReconciliationEntry{
+			Account:  account1,
+			Currency: currency,
+			Type:     "ACTIVE",
+			Result:   ReconciliationSuccess,
+		}
+		assert.NoError(t, NewReconciliationStorage(database).Store(ctx, entry))
+		assert.NotZero(t, entry.Timestamp)
+	})
+
+	t.Run("time range", func(t *testing.T) {
+		start := int64(1500)
+		end := int64(3000)
+		entries, err := r.Get(ctx, &ReconciliationQuery{
+			StartTime: &start,
+			EndTime:   &end,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*ReconciliationEntry{failure, skipped}, entries)
+	})
+
+	t.Run("block range", func(t *testing.T) {
+		start := int64(2)
+		entries, err := r.Get(ctx, &ReconciliationQuery{
+			StartIndex: &start,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*ReconciliationEntry{failure}, entries)
+	})
+
+	t.Run("account and limit", func(t *testing.T) {
+		entries, err := r.Get(ctx, &ReconciliationQuery{
+			Account:  account1,
+			Currency: currency,
+			Limit:    1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*ReconciliationEntry{success}, entries)
+	})
+
+	t.Run("result", func(t *testing.T) {
+		entries, err := r.Get(ctx, &ReconciliationQuery{
+			Result: ReconciliationSkipped,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*ReconciliationEntry{skipped}, entries)
+	})
+
+	t.Run("export jsonl", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, r.ExportJSONL(ctx, &buf, nil))
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert.Len(t, lines, 3)
+
+		var entry ReconciliationEntry
+		assert.NoError(t, json.Unmarshal([]byte(lines[1]), &entry))
+		assert.Equal(t, failure, &entry)
+	})
+
+	t.Run("export csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, r.ExportCSV(ctx, &buf, &ReconciliationQuery{
+			Account: account2,
+		}))
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{
+			reconciliationCSVHeader,
+			{
+				"2000",
+				"INACTIVE",
+				ReconciliationFailure,
+				`{"address":"addr 2"}`,
+				`{"symbol":"BTC","decimals":8}`,
+				"2",
+				"block 2",
+				"100",
+				"105",
+				"",
+			},
+		}, records)
+	})
+}
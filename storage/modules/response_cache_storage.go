@@ -0,0 +1,81 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dominant-strategies/mesh-sdk-go/storage/database"
+)
+
+const (
+	responseCacheNamespace = "response-cache"
+)
+
+func getResponseCacheKey(key string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", responseCacheNamespace, key))
+}
+
+// ResponseCacheStorage implements fetcher.CacheBackend and
+// server.CacheBackend to persist immutable responses.
+type ResponseCacheStorage struct {
+	db database.Database
+}
+
+// NewResponseCacheStorage returns a new ResponseCacheStorage.
+func NewResponseCacheStorage(
+	db database.Database,
+) *ResponseCacheStorage {
+	return &ResponseCacheStorage{
+		db: db,
+	}
+}
+
+// Get returns the cached response stored for key.
+func (r *ResponseCacheStorage) Get(
+	ctx context.Context,
+	key string,
+) (bool, []byte, error) {
+	dbTx := r.db.ReadTransaction(ctx)
+	defer dbTx.Discard(ctx)
+
+	exists, value, err := dbTx.Get(ctx, getResponseCacheKey(key))
+	if err != nil {
+		return false, nil, fmt.Errorf("unable to get cached response: %w", err)
+	}
+
+	return exists, value, nil
+}
+
+// Set stores a cached response for key.
+func (r *ResponseCacheStorage) Set(
+	ctx context.Context,
+	key string,
+	value []byte,
+) error {
+	dbTx := r.db.WriteTransaction(ctx, responseCacheNamespace, false)
+	defer dbTx.Discard(ctx)
+
+	if err := dbTx.Set(ctx, getResponseCacheKey(key), value, true); err != nil {
+		return fmt.Errorf("unable to store cached response: %w", err)
+	}
+
+	if err := dbTx.Commit(ctx); err != nil {
+		return fmt.Errorf("unable to commit cached response: %w", err)
+	}
+
+	return nil
+}
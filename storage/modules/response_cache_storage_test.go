@@ -0,0 +1,56 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/fetcher"
+	"github.com/dominant-strategies/mesh-sdk-go/utils"
+)
+
+func TestResponseCacheStorage(t *testing.T) {
+	ctx := context.Background()
+
+	newDir, err := utils.CreateTempDir()
+	assert.NoError(t, err)
+	defer utils.RemoveTempDir(newDir)
+
+	database, err := newTestBadgerDatabase(ctx, newDir)
+	assert.NoError(t, err)
+	defer database.Close(ctx)
+
+	var _ fetcher.CacheBackend = NewResponseCacheStorage(database)
+	r := NewResponseCacheStorage(database)
+
+	t.Run("missing key", func(t *testing.T) {
+		exists, value, err := r.Get(ctx, "key")
+		assert.NoError(t, err)
+		assert.False(t, exists)
+		assert.Nil(t, value)
+	})
+
+	t.Run("set and get", func(t *testing.T) {
+		assert.NoError(t, r.Set(ctx, "key", []byte("value")))
+
+		exists, value, err := r.Get(ctx, "key")
+		assert.NoError(t, err)
+		assert.True(t, exists)
+		assert.Equal(t, []byte("value"), value)
+	})
+}
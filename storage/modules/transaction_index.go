@@ -0,0 +1,995 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/dominant-strategies/mesh-sdk-go/storage/database"
+	storageErrs "github.com/dominant-strategies/mesh-sdk-go/storage/errors"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// TransactionIndex is a secondary index of the transactions
+// in canonical blocks that can be maintained by BlockStorage.
+type TransactionIndex string
+
+const (
+	// AccountIndex indexes transactions by the
+	// *types.AccountIdentifier (and address) of
+	// each operation.
+	AccountIndex TransactionIndex = "account"
+
+	// OperationTypeIndex indexes transactions by
+	// the type of each operation.
+	OperationTypeIndex TransactionIndex = "type"
+
+	// StatusIndex indexes transactions by the
+	// status of each operation.
+	StatusIndex TransactionIndex = "status"
+
+	// CurrencyIndex indexes transactions by the
+	// *types.Currency of each operation amount.
+	CurrencyIndex TransactionIndex = "currency"
+
+	// CoinIndex indexes transactions by the
+	// *types.CoinIdentifier of each coin change.
+	CoinIndex TransactionIndex = "coin"
+)
+
+// transactionIndexes are all indexes
+// that BlockStorage can maintain.
+var transactionIndexes = []TransactionIndex{
+	AccountIndex,
+	OperationTypeIndex,
+	StatusIndex,
+	CurrencyIndex,
+	CoinIndex,
+}
+
+const (
+	// transactionIndexNamespace is prepended to any
+	// stored transaction index entry.
+	transactionIndexNamespace = "transaction-index"
+
+	// transactionIndexRecordNamespace is prepended to the
+	// records of the index entries written for each
+	// transaction (so that they can be removed even if
+	// the enabled indexes change).
+	transactionIndexRecordNamespace = "transaction-index-record"
+
+	// transactionIndexStartNamespace is prepended to the
+	// index of the oldest block indexed by each index.
+	transactionIndexStartNamespace = "transaction-index-start"
+
+	// addressIndexField is the field of AccountIndex
+	// entries keyed by account address.
+	addressIndexField = "address"
+
+	// defaultSearchLimit is the number of transactions
+	// returned by SearchTransactions when no limit is
+	// provided.
+	defaultSearchLimit = 100
+
+	// maxSearchCount is the number of matching entries
+	// counted by searches with a single index condition
+	// (unless the requested page ends after it).
+	maxSearchCount = 10000
+)
+
+var errSearchCountLimit = errors.New("search count limit reached")
+
+// indexedTransaction is the value of each
+// transaction index entry.
+type indexedTransaction struct {
+	BlockIdentifier       *types.BlockIdentifier       `json:"block_identifier"`
+	TransactionIdentifier *types.TransactionIdentifier `json:"transaction_identifier"`
+}
+
+// WithTransactionIndexes maintains the provided secondary
+// indexes of the transactions in canonical blocks (which are
+// used by SearchTransactions). Indexes are only populated for
+// blocks added after they are enabled, so searches using an
+// index enabled on an existing database (or re-enabled after
+// blocks were added without it) return
+// ErrTransactionIndexIncomplete until BackfillTransactionIndexes
+// is called.
+func WithTransactionIndexes(indexes ...TransactionIndex) BlockStorageOption {
+	return func(b *BlockStorage) {
+		for _, index := range indexes {
+			b.indexes[index] = struct{}{}
+		}
+	}
+}
+
+// getTransactionIndexPrefix returns the prefix of all
+// entries of a field with a value. The value is hashed
+// so that it cannot contain the key separator.
+func getTransactionIndexPrefix(field string, value interface{}) []byte {
+	return []byte(
+		fmt.Sprintf("%s/%s/%s/", transactionIndexNamespace, field, types.Hash(value)),
+	)
+}
+
+// getTransactionIndexKey returns the key of a transaction index
+// entry. The block index is padded so that entries are sorted
+// by block index.
+func getTransactionIndexKey(
+	prefix []byte,
+	blockIdentifier *types.BlockIdentifier,
+	transactionIdentifier *types.TransactionIdentifier,
+) []byte {
+	return []byte(fmt.Sprintf(
+		"%s%s/%s/%s",
+		prefix,
+		getTransactionIndexSortKey(blockIdentifier.Index),
+		blockIdentifier.Hash,
+		transactionIdentifier.Hash,
+	))
+}
+
+func getTransactionIndexSortKey(index int64) string {
+	return fmt.Sprintf("%020d", index)
+}
+
+// getTransactionIndexRecordPrefix returns the prefix of the
+// records of the transactions in a block.
+func getTransactionIndexRecordPrefix(blockIdentifier *types.BlockIdentifier) []byte {
+	return []byte(
+		fmt.Sprintf("%s/%s/", transactionIndexRecordNamespace, blockIdentifier.Hash),
+	)
+}
+
+// getTransactionIndexRecordKey returns the key of the record
+// of the index entries written for a transaction.
+func getTransactionIndexRecordKey(
+	blockIdentifier *types.BlockIdentifier,
+	transactionIdentifier *types.TransactionIdentifier,
+) []byte {
+	return []byte(fmt.Sprintf(
+		"%s%s",
+		getTransactionIndexRecordPrefix(blockIdentifier),
+		transactionIdentifier.Hash,
+	))
+}
+
+func getTransactionIndexStartKey(index TransactionIndex) []byte {
+	return []byte(fmt.Sprintf("%s/%s", transactionIndexStartNamespace, index))
+}
+
+// transactionIndexPrefixes returns the prefixes of the index
+// entries of a transaction in indexes (without duplicates).
+func transactionIndexPrefixes(
+	tx *types.Transaction,
+	indexes map[TransactionIndex]struct{},
+) map[string]struct{} {
+	prefixes := map[string]struct{}{}
+	add := func(index TransactionIndex, field string, value interface{}) {
+		if _, ok := indexes[index]; ok {
+			prefixes[string(getTransactionIndexPrefix(field, value))] = struct{}{}
+		}
+	}
+
+	for _, op := range tx.Operations {
+		if op.Account != nil {
+			add(AccountIndex, string(AccountIndex), op.Account)
+			add(AccountIndex, addressIndexField, op.Account.Address)
+		}
+
+		add(OperationTypeIndex, string(OperationTypeIndex), op.Type)
+
+		if op.Status != nil {
+			add(StatusIndex, string(StatusIndex), *op.Status)
+		}
+
+		if op.Amount != nil {
+			add(CurrencyIndex, string(CurrencyIndex), op.Amount.Currency)
+		}
+
+		if op.CoinChange != nil {
+			add(CoinIndex, string(CoinIndex), op.CoinChange.CoinIdentifier)
+		}
+	}
+
+	return prefixes
+}
+
+// getTransactionIndexStart returns the index of the oldest block
+// indexed by index and a boolean indicating if any block has been
+// indexed.
+func (b *BlockStorage) getTransactionIndexStart(
+	ctx context.Context,
+	dbTx database.Transaction,
+	index TransactionIndex,
+) (int64, bool, error) {
+	exists, rawStart, err := dbTx.Get(ctx, getTransactionIndexStartKey(index))
+	if err != nil {
+		return -1, false, fmt.Errorf("unable to get %s index start: %w", index, err)
+	}
+
+	if !exists {
+		return -1, false, nil
+	}
+
+	start, err := strconv.ParseInt(string(rawStart), 10, 64)
+	if err != nil {
+		return -1, false, fmt.Errorf("unable to parse int for %s index start: %w", index, err)
+	}
+
+	return start, true, nil
+}
+
+func (b *BlockStorage) setTransactionIndexStart(
+	ctx context.Context,
+	dbTx database.Transaction,
+	index TransactionIndex,
+	start int64,
+) error {
+	value := []byte(strconv.FormatInt(start, 10))
+	if err := dbTx.Set(ctx, getTransactionIndexStartKey(index), value, true); err != nil {
+		return fmt.Errorf("unable to set %s index start: %w", index, err)
+	}
+
+	return nil
+}
+
+// indexTransaction adds the entries of a transaction in indexes
+// and records their prefixes (merging them with any prefixes
+// already recorded for the transaction).
+func (b *BlockStorage) indexTransaction(
+	ctx context.Context,
+	dbTx database.Transaction,
+	blockIdentifier *types.BlockIdentifier,
+	tx *types.Transaction,
+	indexes map[TransactionIndex]struct{},
+) error {
+	prefixes := transactionIndexPrefixes(tx, indexes)
+	if len(prefixes) == 0 {
+		return nil
+	}
+
+	recordKey := getTransactionIndexRecordKey(blockIdentifier, tx.TransactionIdentifier)
+	exists, rawRecord, err := dbTx.Get(ctx, recordKey)
+	if err != nil {
+		return fmt.Errorf("unable to get transaction index record: %w", err)
+	}
+
+	record := []string{}
+	if exists {
+		err := b.db.Encoder().Decode(transactionIndexRecordNamespace, rawRecord, &record, true)
+		if err != nil {
+			return fmt.Errorf("unable to decode transaction index record: %w", err)
+		}
+	}
+
+	for _, prefix := range record {
+		delete(prefixes, prefix)
+	}
+
+	for prefix := range prefixes {
+		// Each value is reclaimed when the transaction is
+		// committed, so it cannot be shared between keys.
+		value, err := b.db.Encoder().Encode(transactionIndexNamespace, &indexedTransaction{
+			BlockIdentifier:       blockIdentifier,
+			TransactionIdentifier: tx.TransactionIdentifier,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to encode transaction index entry: %w", err)
+		}
+
+		key := getTransactionIndexKey([]byte(prefix), blockIdentifier, tx.TransactionIdentifier)
+		if err := dbTx.Set(ctx, key, value, true); err != nil {
+			return fmt.Errorf("unable to set transaction index entry: %w", err)
+		}
+
+		record = append(record, prefix)
+	}
+
+	sort.Strings(record)
+	value, err := b.db.Encoder().Encode(transactionIndexRecordNamespace, record)
+	if err != nil {
+		return fmt.Errorf("unable to encode transaction index record: %w", err)
+	}
+
+	if err := dbTx.Set(ctx, recordKey, value, true); err != nil {
+		return fmt.Errorf("unable to set transaction index record: %w", err)
+	}
+
+	return nil
+}
+
+// storeTransactionIndexes adds the enabled index entries of each
+// transaction in an added block. Blocks stored with SeeBlockStream
+// may be added without their transactions, so any transaction not
+// in block is read from storage (one at a time).
+func (b *BlockStorage) storeTransactionIndexes(
+	ctx context.Context,
+	dbTx database.Transaction,
+	block *types.Block,
+) error {
+	// Indexes that are not enabled miss this block, so
+	// their start is cleared (otherwise re-enabling them
+	// would leave a gap that is never backfilled).
+	for _, index := range transactionIndexes {
+		if _, ok := b.indexes[index]; ok {
+			continue
+		}
+
+		if err := dbTx.Delete(ctx, getTransactionIndexStartKey(index)); err != nil {
+			return fmt.Errorf("unable to delete %s index start: %w", index, err)
+		}
+	}
+
+	if len(b.indexes) == 0 {
+		return nil
+	}
+
+	blockIdentifier := block.BlockIdentifier
+	for index := range b.indexes {
+		_, exists, err := b.getTransactionIndexStart(ctx, dbTx, index)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			continue
+		}
+
+		if err := b.setTransactionIndexStart(ctx, dbTx, index, blockIdentifier.Index); err != nil {
+			return err
+		}
+	}
+
+	stored, err := b.GetBlockLazyTransactional(
+		ctx,
+		types.ConstructPartialBlockIdentifier(blockIdentifier),
+		dbTx,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to get block: %w", err)
+	}
+
+	transactions := make(map[string]*types.Transaction, len(block.Transactions))
+	for _, tx := range block.Transactions {
+		transactions[tx.TransactionIdentifier.Hash] = tx
+	}
+
+	for _, transactionIdentifier := range stored.OtherTransactions {
+		tx, ok := transactions[transactionIdentifier.Hash]
+		if !ok {
+			tx, err = b.findBlockTransaction(ctx, blockIdentifier, transactionIdentifier, dbTx)
+			if err != nil {
+				return fmt.Errorf("unable to get transaction: %w", err)
+			}
+		}
+
+		if err := b.indexTransaction(ctx, dbTx, blockIdentifier, tx, b.indexes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeTransactionIndexes removes the index entries recorded
+// for each transaction in a block (regardless of the indexes
+// that are currently enabled).
+func (b *BlockStorage) removeTransactionIndexes(
+	ctx context.Context,
+	dbTx database.Transaction,
+	blockIdentifier *types.BlockIdentifier,
+) error {
+	recordPrefix := getTransactionIndexRecordPrefix(blockIdentifier)
+	records := map[string][]string{}
+	_, err := dbTx.Scan(
+		ctx,
+		recordPrefix,
+		recordPrefix,
+		func(k []byte, v []byte) error {
+			var record []string
+			// We should not reclaim memory during a scan!!
+			err := b.db.Encoder().Decode(transactionIndexRecordNamespace, v, &record, false)
+			if err != nil {
+				return fmt.Errorf("unable to decode transaction index record: %w", err)
+			}
+
+			records[string(k)] = record
+			return nil
+		},
+		false,
+		false,
+	)
+	if err != nil {
+		return fmt.Errorf("database scan failed: %w", err)
+	}
+
+	for recordKey, record := range records {
+		transactionIdentifier := &types.TransactionIdentifier{
+			Hash: recordKey[len(recordPrefix):],
+		}
+		for _, prefix := range record {
+			key := getTransactionIndexKey([]byte(prefix), blockIdentifier, transactionIdentifier)
+			if err := dbTx.Delete(ctx, key); err != nil {
+				return fmt.Errorf("unable to delete transaction index entry: %w", err)
+			}
+		}
+
+		if err := dbTx.Delete(ctx, []byte(recordKey)); err != nil {
+			return fmt.Errorf("unable to delete transaction index record: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// BackfillTransactionIndexes populates the enabled transaction indexes
+// for the blocks added before they were enabled. Blocks are indexed
+// from newest to oldest, each in its own database transaction, so an
+// interrupted backfill resumes where it stopped when called again.
+func (b *BlockStorage) BackfillTransactionIndexes(ctx context.Context) error {
+	for {
+		done, err := b.backfillBlock(ctx)
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+	}
+}
+
+// backfillBlock indexes the newest block that is not indexed by
+// all enabled indexes and returns a boolean indicating if all
+// enabled indexes are complete.
+func (b *BlockStorage) backfillBlock(ctx context.Context) (bool, error) {
+	dbTx := b.db.WriteTransaction(ctx, blockSyncIdentifier, false)
+	defer dbTx.Discard(ctx)
+
+	head, err := b.GetHeadBlockIdentifierTransactional(ctx, dbTx)
+	if errors.Is(err, storageErrs.ErrHeadBlockNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("unable to get head block identifier: %w", err)
+	}
+
+	oldestIndex, err := b.GetOldestBlockIndexTransactional(ctx, dbTx)
+	if err != nil {
+		return false, fmt.Errorf("unable to get oldest block index: %w", err)
+	}
+
+	// pending are the indexes with the newest start
+	// (which all need the block before it).
+	pending := map[TransactionIndex]struct{}{}
+	newestStart := oldestIndex
+	for index := range b.indexes {
+		start, exists, err := b.getTransactionIndexStart(ctx, dbTx, index)
+		if err != nil {
+			return false, err
+		}
+
+		// No block has been added since the
+		// index was enabled.
+		if !exists {
+			start = head.Index + 1
+		}
+
+		switch {
+		case start > newestStart:
+			newestStart = start
+			pending = map[TransactionIndex]struct{}{index: {}}
+		case start == newestStart && start > oldestIndex:
+			pending[index] = struct{}{}
+		}
+	}
+
+	if len(pending) == 0 {
+		return true, nil
+	}
+
+	blockIndex := newestStart - 1
+	blockResponse, err := b.GetBlockLazyTransactional(
+		ctx,
+		&types.PartialBlockIdentifier{Index: &blockIndex},
+		dbTx,
+	)
+	if err != nil && !errors.Is(err, storageErrs.ErrBlockNotFound) {
+		return false, fmt.Errorf("unable to get block: %w", err)
+	}
+
+	// Omitted blocks have no transactions to index.
+	if err == nil {
+		blockIdentifier := blockResponse.Block.BlockIdentifier
+		for _, transactionIdentifier := range blockResponse.OtherTransactions {
+			tx, err := b.findBlockTransaction(ctx, blockIdentifier, transactionIdentifier, dbTx)
+			if err != nil {
+				return false, fmt.Errorf("unable to get transaction: %w", err)
+			}
+
+			if err := b.indexTransaction(ctx, dbTx, blockIdentifier, tx, pending); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	for index := range pending {
+		if err := b.setTransactionIndexStart(ctx, dbTx, index, blockIndex); err != nil {
+			return false, err
+		}
+	}
+
+	if err := dbTx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("unable to commit transaction: %w", err)
+	}
+
+	return false, nil
+}
+
+// decodeTransactionIndexEntry decodes an entry
+// read during a scan (without reclaiming v).
+func (b *BlockStorage) decodeTransactionIndexEntry(v []byte) (*indexedTransaction, error) {
+	var entry indexedTransaction
+	// We should not reclaim memory during a scan!!
+	err := b.db.Encoder().Decode(transactionIndexNamespace, v, &entry, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode transaction index entry: %w", err)
+	}
+
+	return &entry, nil
+}
+
+// scanTransactionIndex calls worker with the sort key and encoded
+// value of each entry of a field with a value in blocks with index
+// <= maxBlock (from most recent to oldest block). It errors if the
+// index does not include all blocks with index >= oldestIndex.
+func (b *BlockStorage) scanTransactionIndex(
+	ctx context.Context,
+	dbTx database.Transaction,
+	index TransactionIndex,
+	field string,
+	value interface{},
+	oldestIndex int64,
+	maxBlock int64,
+	worker func(sortKey string, v []byte) error,
+) error {
+	if _, ok := b.indexes[index]; !ok {
+		return fmt.Errorf("%s index: %w", index, storageErrs.ErrTransactionIndexMissing)
+	}
+
+	// Entries of pruned blocks are removed, so an index is
+	// complete once it starts at (or before) the oldest block.
+	start, exists, err := b.getTransactionIndexStart(ctx, dbTx, index)
+	if err != nil {
+		return err
+	}
+
+	if !exists || start > oldestIndex {
+		return fmt.Errorf("%s index: %w", index, storageErrs.ErrTransactionIndexIncomplete)
+	}
+
+	prefix := getTransactionIndexPrefix(field, value)
+	seek := append(
+		[]byte(fmt.Sprintf("%s%s/", prefix, getTransactionIndexSortKey(maxBlock))),
+		0xff,
+	)
+	_, err = dbTx.Scan(
+		ctx,
+		prefix,
+		seek,
+		func(k []byte, v []byte) error {
+			return worker(string(k[len(prefix):]), v)
+		},
+		false,
+		true,
+	)
+	if err != nil {
+		return fmt.Errorf("database scan failed: %w", err)
+	}
+
+	return nil
+}
+
+// loadTransactionIndex returns all entries of a field with a
+// value in blocks with index <= maxBlock (keyed by their sort
+// key).
+func (b *BlockStorage) loadTransactionIndex(
+	ctx context.Context,
+	dbTx database.Transaction,
+	index TransactionIndex,
+	field string,
+	value interface{},
+	oldestIndex int64,
+	maxBlock int64,
+) (map[string]*indexedTransaction, error) {
+	entries := map[string]*indexedTransaction{}
+	err := b.scanTransactionIndex(
+		ctx,
+		dbTx,
+		index,
+		field,
+		value,
+		oldestIndex,
+		maxBlock,
+		func(sortKey string, v []byte) error {
+			entry, err := b.decodeTransactionIndexEntry(v)
+			if err != nil {
+				return err
+			}
+
+			entries[sortKey] = entry
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// pageTransactionIndex returns the entries of a field with a value
+// in blocks with index <= maxBlock at [offset, offset+limit) (from
+// most recent to oldest block) and the number of matching entries.
+// Entries are streamed from the index and only those in the page
+// are decoded. Counting stops at maxSearchCount (or one entry past
+// the page), so the count is a lower bound for larger results.
+func (b *BlockStorage) pageTransactionIndex(
+	ctx context.Context,
+	dbTx database.Transaction,
+	index TransactionIndex,
+	field string,
+	value interface{},
+	oldestIndex int64,
+	maxBlock int64,
+	offset int64,
+	limit int64,
+) ([]*indexedTransaction, int64, error) {
+	countLimit := offset + limit + 1
+	if countLimit < maxSearchCount {
+		countLimit = maxSearchCount
+	}
+
+	page := []*indexedTransaction{}
+	count := int64(0)
+	err := b.scanTransactionIndex(
+		ctx,
+		dbTx,
+		index,
+		field,
+		value,
+		oldestIndex,
+		maxBlock,
+		func(sortKey string, v []byte) error {
+			if count >= offset && count < offset+limit {
+				entry, err := b.decodeTransactionIndexEntry(v)
+				if err != nil {
+					return err
+				}
+
+				page = append(page, entry)
+			}
+
+			count++
+			if count >= countLimit {
+				return errSearchCountLimit
+			}
+
+			return nil
+		},
+	)
+	if err != nil && !errors.Is(err, errSearchCountLimit) {
+		return nil, -1, err
+	}
+
+	return page, count, nil
+}
+
+// findCanonicalTransactions returns all occurrences of a transaction
+// in canonical blocks with oldestIndex <= index <= maxBlock (keyed by
+// their sort key).
+func (b *BlockStorage) findCanonicalTransactions(
+	ctx context.Context,
+	dbTx database.Transaction,
+	transactionIdentifier *types.TransactionIdentifier,
+	oldestIndex int64,
+	maxBlock int64,
+) (map[string]*indexedTransaction, error) {
+	blockTransactions, err := b.getAllTransactionsByIdentifier(ctx, transactionIdentifier, dbTx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query database for transaction: %w", err)
+	}
+
+	entries := map[string]*indexedTransaction{}
+	for _, blockTransaction := range blockTransactions {
+		blockIdentifier := blockTransaction.BlockIdentifier
+		if blockIdentifier.Index < oldestIndex || blockIdentifier.Index > maxBlock {
+			continue
+		}
+
+		// Transactions are stored when a block is seen,
+		// so they may be in a block that was never added.
+		exists, blockKey, err := dbTx.Get(ctx, getBlockIndexKey(blockIdentifier.Index))
+		if err != nil {
+			return nil, fmt.Errorf("unable to get block index: %w", err)
+		}
+
+		if _, key := getBlockHashKey(blockIdentifier.Hash); !exists || string(blockKey) != string(key) {
+			continue
+		}
+
+		sortKey := string(getTransactionIndexKey(nil, blockIdentifier, transactionIdentifier))
+		entries[sortKey] = &indexedTransaction{
+			BlockIdentifier:       blockIdentifier,
+			TransactionIdentifier: transactionIdentifier,
+		}
+	}
+
+	return entries, nil
+}
+
+// SearchTransactions returns the transactions in canonical blocks that
+// match the conditions of a *types.SearchTransactionsRequest (using the
+// indexes enabled with WithTransactionIndexes), sorted from most recent
+// block to oldest block. Transactions in pruned blocks are not returned.
+//
+// Searches with a single index condition are streamed from the index,
+// so their TotalCount stops at maxSearchCount (or one past the end of
+// the requested page). Searches combining multiple conditions load all
+// matches of each condition and return an exact TotalCount.
+func (b *BlockStorage) SearchTransactions(
+	ctx context.Context,
+	request *types.SearchTransactionsRequest,
+) (*types.SearchTransactionsResponse, error) {
+	dbTx := b.db.ReadTransaction(ctx)
+	defer dbTx.Discard(ctx)
+
+	return b.SearchTransactionsTransactional(ctx, request, dbTx)
+}
+
+// SearchTransactionsTransactional returns the transactions that
+// match the conditions of a *types.SearchTransactionsRequest in
+// a database transaction.
+func (b *BlockStorage) SearchTransactionsTransactional(
+	ctx context.Context,
+	request *types.SearchTransactionsRequest,
+	dbTx database.Transaction,
+) (*types.SearchTransactionsResponse, error) {
+	if request.Success != nil {
+		return nil, fmt.Errorf("success: %w", storageErrs.ErrSearchConditionUnsupported)
+	}
+
+	head, err := b.GetHeadBlockIdentifierTransactional(ctx, dbTx)
+	if errors.Is(err, storageErrs.ErrHeadBlockNotFound) {
+		return &types.SearchTransactionsResponse{Transactions: []*types.BlockTransaction{}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to get head block identifier: %w", err)
+	}
+
+	// Transactions in pruned blocks cannot be returned.
+	oldestIndex, err := b.GetOldestBlockIndexTransactional(ctx, dbTx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get oldest block index: %w", err)
+	}
+
+	maxBlock := head.Index
+	if request.MaxBlock != nil && *request.MaxBlock < maxBlock {
+		maxBlock = *request.MaxBlock
+	}
+
+	offset := int64(0)
+	if request.Offset != nil {
+		offset = *request.Offset
+	}
+	limit := int64(defaultSearchLimit)
+	if request.Limit != nil {
+		limit = *request.Limit
+	}
+
+	// pageIndex streams a page of the last index condition
+	// (which is used when it is the only condition).
+	var pageIndex func() ([]*indexedTransaction, int64, error)
+	indexCondition := func(
+		index TransactionIndex,
+		field string,
+		value interface{},
+	) searchCondition {
+		pageIndex = func() ([]*indexedTransaction, int64, error) {
+			return b.pageTransactionIndex(
+				ctx,
+				dbTx,
+				index,
+				field,
+				value,
+				oldestIndex,
+				maxBlock,
+				offset,
+				limit,
+			)
+		}
+
+		return func() (map[string]*indexedTransaction, error) {
+			return b.loadTransactionIndex(ctx, dbTx, index, field, value, oldestIndex, maxBlock)
+		}
+	}
+
+	conditions := []searchCondition{}
+	if request.TransactionIdentifier != nil {
+		conditions = append(conditions, func() (map[string]*indexedTransaction, error) {
+			return b.findCanonicalTransactions(
+				ctx,
+				dbTx,
+				request.TransactionIdentifier,
+				oldestIndex,
+				maxBlock,
+			)
+		})
+	}
+	if request.AccountIdentifier != nil {
+		conditions = append(
+			conditions,
+			indexCondition(AccountIndex, string(AccountIndex), request.AccountIdentifier),
+		)
+	}
+	if request.Address != nil {
+		conditions = append(
+			conditions,
+			indexCondition(AccountIndex, addressIndexField, *request.Address),
+		)
+	}
+	if request.Type != nil {
+		conditions = append(
+			conditions,
+			indexCondition(OperationTypeIndex, string(OperationTypeIndex), *request.Type),
+		)
+	}
+	if request.Status != nil {
+		conditions = append(
+			conditions,
+			indexCondition(StatusIndex, string(StatusIndex), *request.Status),
+		)
+	}
+	if request.Currency != nil {
+		conditions = append(
+			conditions,
+			indexCondition(CurrencyIndex, string(CurrencyIndex), request.Currency),
+		)
+	}
+	if request.CoinIdentifier != nil {
+		conditions = append(
+			conditions,
+			indexCondition(CoinIndex, string(CoinIndex), request.CoinIdentifier),
+		)
+	}
+	if len(conditions) == 0 {
+		return nil, storageErrs.ErrSearchConditionsMissing
+	}
+
+	operator := types.AND
+	if request.Operator != nil {
+		operator = *request.Operator
+	}
+	if operator != types.AND && operator != types.OR {
+		return nil, fmt.Errorf(
+			"operator %s: %w",
+			operator,
+			storageErrs.ErrSearchConditionUnsupported,
+		)
+	}
+
+	var (
+		page       []*indexedTransaction
+		totalCount int64
+	)
+	if len(conditions) == 1 && request.TransactionIdentifier == nil {
+		page, totalCount, err = pageIndex()
+	} else {
+		page, totalCount, err = pageConditions(conditions, operator, offset, limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := []*types.BlockTransaction{}
+	for _, entry := range page {
+		transaction, err := b.findBlockTransaction(
+			ctx,
+			entry.BlockIdentifier,
+			entry.TransactionIdentifier,
+			dbTx,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to get transaction %s: %w",
+				types.PrintStruct(entry.TransactionIdentifier),
+				err,
+			)
+		}
+
+		transactions = append(transactions, &types.BlockTransaction{
+			BlockIdentifier: entry.BlockIdentifier,
+			Transaction:     transaction,
+		})
+	}
+
+	var nextOffset *int64
+	if end := offset + int64(len(transactions)); end < totalCount {
+		nextOffset = &end
+	}
+
+	return &types.SearchTransactionsResponse{
+		Transactions: transactions,
+		TotalCount:   totalCount,
+		NextOffset:   nextOffset,
+	}, nil
+}
+
+// searchCondition returns the entries matching a
+// search condition (keyed by their sort key).
+type searchCondition func() (map[string]*indexedTransaction, error)
+
+// pageConditions returns the entries matching all (AND) or any (OR)
+// conditions at [offset, offset+limit) (from most recent to oldest
+// block) and the number of matching entries.
+func pageConditions(
+	conditions []searchCondition,
+	operator types.Operator,
+	offset int64,
+	limit int64,
+) ([]*indexedTransaction, int64, error) {
+	var matches map[string]*indexedTransaction
+	for i, condition := range conditions {
+		entries, err := condition()
+		if err != nil {
+			return nil, -1, err
+		}
+
+		switch {
+		case i == 0:
+			matches = entries
+		case operator == types.AND:
+			for key := range matches {
+				if _, ok := entries[key]; !ok {
+					delete(matches, key)
+				}
+			}
+		default:
+			for key, entry := range entries {
+				matches[key] = entry
+			}
+		}
+	}
+
+	// Sort keys start with the padded block index, so
+	// the most recent transactions have the largest keys.
+	keys := make([]string, 0, len(matches))
+	for key := range matches {
+		keys = append(keys, key)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+
+	totalCount := int64(len(keys))
+	page := []*indexedTransaction{}
+	for i := offset; i < totalCount && i < offset+limit; i++ {
+		page = append(page, matches[keys[i]])
+	}
+
+	return page, totalCount, nil
+}
@@ -0,0 +1,479 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	storageErrs "github.com/dominant-strategies/mesh-sdk-go/storage/errors"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+	"github.com/dominant-strategies/mesh-sdk-go/utils"
+)
+
+var (
+	indexAccountA = &types.AccountIdentifier{Address: "A"}
+	indexAccountB = &types.AccountIdentifier{
+		Address:    "B",
+		SubAccount: &types.SubAccountIdentifier{Address: "stake"},
+	}
+	indexAccountC = &types.AccountIdentifier{Address: "C"}
+
+	indexBTC = &types.Currency{Symbol: "BTC", Decimals: 8}
+	indexETH = &types.Currency{Symbol: "ETH", Decimals: 18}
+)
+
+func indexTestOperation(
+	account *types.AccountIdentifier,
+	opType string,
+	status string,
+	currency *types.Currency,
+) *types.Operation {
+	return &types.Operation{
+		OperationIdentifier: &types.OperationIdentifier{Index: 0},
+		Type:                opType,
+		Status:              types.String(status),
+		Account:             account,
+		Amount:              &types.Amount{Value: "1", Currency: currency},
+	}
+}
+
+func indexTestTransaction(hash string, ops ...*types.Operation) *types.Transaction {
+	return &types.Transaction{
+		TransactionIdentifier: &types.TransactionIdentifier{Hash: hash},
+		Operations:            ops,
+	}
+}
+
+func TestSearchTransactions(t *testing.T) {
+	coinOp := indexTestOperation(indexAccountB, "transfer", "success", indexBTC)
+	coinOp.CoinChange = &types.CoinChange{
+		CoinIdentifier: &types.CoinIdentifier{Identifier: "coin 1"},
+		CoinAction:     types.CoinCreated,
+	}
+
+	var (
+		tx0 = indexTestTransaction(
+			"tx 0",
+			indexTestOperation(indexAccountA, "transfer", "success", indexBTC),
+		)
+		tx1 = indexTestTransaction(
+			"tx 1",
+			indexTestOperation(indexAccountA, "transfer", "success", indexBTC),
+			coinOp,
+		)
+		tx2 = indexTestTransaction(
+			"tx 2",
+			indexTestOperation(indexAccountC, "fee", "failed", indexETH),
+		)
+		tx3 = indexTestTransaction(
+			"tx 3",
+			indexTestOperation(indexAccountB, "transfer", "success", indexBTC),
+		)
+		tx4 = indexTestTransaction(
+			"tx 4",
+			indexTestOperation(indexAccountA, "transfer", "success", indexBTC),
+		)
+
+		block0 = &types.Block{
+			BlockIdentifier:       &types.BlockIdentifier{Hash: "block 0", Index: 0},
+			ParentBlockIdentifier: &types.BlockIdentifier{Hash: "block 0", Index: 0},
+			Transactions:          []*types.Transaction{tx0},
+		}
+		block1 = &types.Block{
+			BlockIdentifier:       &types.BlockIdentifier{Hash: "block 1", Index: 1},
+			ParentBlockIdentifier: block0.BlockIdentifier,
+			Transactions:          []*types.Transaction{tx1, tx2},
+		}
+		block2 = &types.Block{
+			BlockIdentifier:       &types.BlockIdentifier{Hash: "block 2", Index: 2},
+			ParentBlockIdentifier: block1.BlockIdentifier,
+			Transactions:          []*types.Transaction{tx3},
+		}
+
+		// orphanBlock is seen but never added.
+		orphanBlock = &types.Block{
+			BlockIdentifier:       &types.BlockIdentifier{Hash: "orphan 2", Index: 2},
+			ParentBlockIdentifier: block1.BlockIdentifier,
+			Transactions:          []*types.Transaction{tx4},
+		}
+	)
+
+	blockTransaction := func(
+		block *types.Block,
+		tx *types.Transaction,
+	) *types.BlockTransaction {
+		return &types.BlockTransaction{
+			BlockIdentifier: block.BlockIdentifier,
+			Transaction:     tx,
+		}
+	}
+
+	ctx := context.Background()
+
+	newDir, err := utils.CreateTempDir()
+	assert.NoError(t, err)
+	defer utils.RemoveTempDir(newDir)
+
+	database, err := newTestBadgerDatabase(ctx, newDir)
+	assert.NoError(t, err)
+	defer database.Close(ctx)
+
+	storage := NewBlockStorage(
+		database,
+		blockWorkerConcurrency,
+		WithTransactionIndexes(
+			AccountIndex,
+			OperationTypeIndex,
+			StatusIndex,
+			CurrencyIndex,
+			CoinIndex,
+		),
+	)
+
+	t.Run("No blocks", func(t *testing.T) {
+		response, err := storage.SearchTransactions(ctx, &types.SearchTransactionsRequest{
+			AccountIdentifier: indexAccountA,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &types.SearchTransactionsResponse{
+			Transactions: []*types.BlockTransaction{},
+		}, response)
+	})
+
+	for _, block := range []*types.Block{block0, block1, block2} {
+		assert.NoError(t, storage.SeeBlock(ctx, block))
+		assert.NoError(t, storage.AddBlock(ctx, block))
+	}
+	assert.NoError(t, storage.SeeBlock(ctx, orphanBlock))
+
+	var tests = map[string]struct {
+		request *types.SearchTransactionsRequest
+
+		expectedResponse *types.SearchTransactionsResponse
+		expectedErr      error
+	}{
+		"account": {
+			request: &types.SearchTransactionsRequest{
+				AccountIdentifier: indexAccountA,
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{
+					blockTransaction(block1, tx1),
+					blockTransaction(block0, tx0),
+				},
+				TotalCount: 2,
+			},
+		},
+		"address": {
+			request: &types.SearchTransactionsRequest{
+				Address: types.String("B"),
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{
+					blockTransaction(block2, tx3),
+					blockTransaction(block1, tx1),
+				},
+				TotalCount: 2,
+			},
+		},
+		"coin": {
+			request: &types.SearchTransactionsRequest{
+				CoinIdentifier: &types.CoinIdentifier{Identifier: "coin 1"},
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{
+					blockTransaction(block1, tx1),
+				},
+				TotalCount: 1,
+			},
+		},
+		"status and currency": {
+			request: &types.SearchTransactionsRequest{
+				Status:   types.String("failed"),
+				Currency: indexETH,
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{
+					blockTransaction(block1, tx2),
+				},
+				TotalCount: 1,
+			},
+		},
+		"account and type": {
+			request: &types.SearchTransactionsRequest{
+				AccountIdentifier: indexAccountA,
+				Type:              types.String("fee"),
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{},
+			},
+		},
+		"account or type": {
+			request: &types.SearchTransactionsRequest{
+				Operator:          types.OperatorP(types.OR),
+				AccountIdentifier: indexAccountA,
+				Type:              types.String("fee"),
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{
+					blockTransaction(block1, tx2),
+					blockTransaction(block1, tx1),
+					blockTransaction(block0, tx0),
+				},
+				TotalCount: 3,
+			},
+		},
+		"max block": {
+			request: &types.SearchTransactionsRequest{
+				AccountIdentifier: indexAccountA,
+				MaxBlock:          types.Int64(0),
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{
+					blockTransaction(block0, tx0),
+				},
+				TotalCount: 1,
+			},
+		},
+		"limit": {
+			request: &types.SearchTransactionsRequest{
+				Type:  types.String("transfer"),
+				Limit: types.Int64(2),
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{
+					blockTransaction(block2, tx3),
+					blockTransaction(block1, tx1),
+				},
+				TotalCount: 3,
+				NextOffset: types.Int64(2),
+			},
+		},
+		"offset": {
+			request: &types.SearchTransactionsRequest{
+				Type:   types.String("transfer"),
+				Offset: types.Int64(2),
+				Limit:  types.Int64(2),
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{
+					blockTransaction(block0, tx0),
+				},
+				TotalCount: 3,
+			},
+		},
+		"transaction identifier and account": {
+			request: &types.SearchTransactionsRequest{
+				TransactionIdentifier: tx1.TransactionIdentifier,
+				AccountIdentifier:     indexAccountB,
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{
+					blockTransaction(block1, tx1),
+				},
+				TotalCount: 1,
+			},
+		},
+		"orphaned transaction": {
+			request: &types.SearchTransactionsRequest{
+				TransactionIdentifier: tx4.TransactionIdentifier,
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{},
+			},
+		},
+		"success": {
+			request: &types.SearchTransactionsRequest{
+				Success: types.Bool(true),
+			},
+			expectedErr: storageErrs.ErrSearchConditionUnsupported,
+		},
+		"no conditions": {
+			request:     &types.SearchTransactionsRequest{},
+			expectedErr: storageErrs.ErrSearchConditionsMissing,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			response, err := storage.SearchTransactions(ctx, test.request)
+			if test.expectedErr != nil {
+				assert.True(t, errors.Is(err, test.expectedErr))
+				assert.Nil(t, response)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, response)
+		})
+	}
+
+	t.Run("Missing index", func(t *testing.T) {
+		storage := NewBlockStorage(database, blockWorkerConcurrency)
+		response, err := storage.SearchTransactions(ctx, &types.SearchTransactionsRequest{
+			AccountIdentifier: indexAccountA,
+		})
+		assert.True(t, errors.Is(err, storageErrs.ErrTransactionIndexMissing))
+		assert.Nil(t, response)
+	})
+
+	t.Run("Remove block", func(t *testing.T) {
+		assert.NoError(t, storage.RemoveBlock(ctx, block2.BlockIdentifier))
+
+		response, err := storage.SearchTransactions(ctx, &types.SearchTransactionsRequest{
+			Address: types.String("B"),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &types.SearchTransactionsResponse{
+			Transactions: []*types.BlockTransaction{
+				blockTransaction(block1, tx1),
+			},
+			TotalCount: 1,
+		}, response)
+	})
+
+	t.Run("Prune block", func(t *testing.T) {
+		_, _, err := storage.Prune(ctx, block0.BlockIdentifier.Index, 0)
+		assert.NoError(t, err)
+
+		response, err := storage.SearchTransactions(ctx, &types.SearchTransactionsRequest{
+			Operator:              types.OperatorP(types.OR),
+			TransactionIdentifier: tx0.TransactionIdentifier,
+			AccountIdentifier:     indexAccountA,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &types.SearchTransactionsResponse{
+			Transactions: []*types.BlockTransaction{
+				blockTransaction(block1, tx1),
+			},
+			TotalCount: 1,
+		}, response)
+	})
+}
+
+func TestBackfillTransactionIndexes(t *testing.T) {
+	var (
+		tx0 = indexTestTransaction(
+			"tx 0",
+			indexTestOperation(indexAccountA, "transfer", "success", indexBTC),
+		)
+		tx1 = indexTestTransaction(
+			"tx 1",
+			indexTestOperation(indexAccountA, "fee", "success", indexBTC),
+		)
+		tx2 = indexTestTransaction(
+			"tx 2",
+			indexTestOperation(indexAccountA, "transfer", "success", indexBTC),
+		)
+
+		block0 = &types.Block{
+			BlockIdentifier:       &types.BlockIdentifier{Hash: "block 0", Index: 0},
+			ParentBlockIdentifier: &types.BlockIdentifier{Hash: "block 0", Index: 0},
+			Transactions:          []*types.Transaction{tx0},
+		}
+		block1 = &types.Block{
+			BlockIdentifier:       &types.BlockIdentifier{Hash: "block 1", Index: 1},
+			ParentBlockIdentifier: block0.BlockIdentifier,
+			Transactions:          []*types.Transaction{tx1},
+		}
+		block2 = &types.Block{
+			BlockIdentifier:       &types.BlockIdentifier{Hash: "block 2", Index: 2},
+			ParentBlockIdentifier: block1.BlockIdentifier,
+			Transactions:          []*types.Transaction{tx2},
+		}
+	)
+
+	ctx := context.Background()
+
+	newDir, err := utils.CreateTempDir()
+	assert.NoError(t, err)
+	defer utils.RemoveTempDir(newDir)
+
+	database, err := newTestBadgerDatabase(ctx, newDir)
+	assert.NoError(t, err)
+	defer database.Close(ctx)
+
+	search := func(storage *BlockStorage) (*types.SearchTransactionsResponse, error) {
+		return storage.SearchTransactions(ctx, &types.SearchTransactionsRequest{
+			AccountIdentifier: indexAccountA,
+		})
+	}
+	hashes := func(response *types.SearchTransactionsResponse) []string {
+		hashes := []string{}
+		for _, tx := range response.Transactions {
+			hashes = append(hashes, tx.Transaction.TransactionIdentifier.Hash)
+		}
+
+		return hashes
+	}
+
+	// Blocks 0 and 1 are added before the
+	// account index is enabled.
+	storage := NewBlockStorage(database, blockWorkerConcurrency)
+	for _, block := range []*types.Block{block0, block1} {
+		assert.NoError(t, storage.SeeBlock(ctx, block))
+		assert.NoError(t, storage.AddBlock(ctx, block))
+	}
+
+	accountStorage := NewBlockStorage(
+		database,
+		blockWorkerConcurrency,
+		WithTransactionIndexes(AccountIndex, OperationTypeIndex),
+	)
+	_, err = search(accountStorage)
+	assert.True(t, errors.Is(err, storageErrs.ErrTransactionIndexIncomplete))
+
+	assert.NoError(t, accountStorage.SeeBlock(ctx, block2))
+	assert.NoError(t, accountStorage.AddBlock(ctx, block2))
+	_, err = search(accountStorage)
+	assert.True(t, errors.Is(err, storageErrs.ErrTransactionIndexIncomplete))
+
+	assert.NoError(t, accountStorage.BackfillTransactionIndexes(ctx))
+	response, err := search(accountStorage)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tx 2", "tx 1", "tx 0"}, hashes(response))
+
+	// Backfilling a complete index does nothing.
+	assert.NoError(t, accountStorage.BackfillTransactionIndexes(ctx))
+
+	// Entries are removed even if their index is no
+	// longer enabled when the block is removed.
+	assert.NoError(t, storage.RemoveBlock(ctx, block2.BlockIdentifier))
+	response, err = search(accountStorage)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tx 1", "tx 0"}, hashes(response))
+
+	response, err = accountStorage.SearchTransactions(ctx, &types.SearchTransactionsRequest{
+		Type: types.String("transfer"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tx 0"}, hashes(response))
+
+	// Blocks added while an index is disabled leave a
+	// gap that must be backfilled when it is re-enabled.
+	assert.NoError(t, storage.SeeBlock(ctx, block2))
+	assert.NoError(t, storage.AddBlock(ctx, block2))
+	_, err = search(accountStorage)
+	assert.True(t, errors.Is(err, storageErrs.ErrTransactionIndexIncomplete))
+
+	assert.NoError(t, accountStorage.BackfillTransactionIndexes(ctx))
+	response, err = search(accountStorage)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"tx 2", "tx 1", "tx 0"}, hashes(response))
+}
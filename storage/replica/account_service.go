@@ -0,0 +1,172 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replica
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/dominant-strategies/mesh-sdk-go/server"
+	storageErrs "github.com/dominant-strategies/mesh-sdk-go/storage/errors"
+	"github.com/dominant-strategies/mesh-sdk-go/storage/modules"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// AccountAPIService implements server.AccountAPIServicer
+// using the balances stored in a *modules.BalanceStorage
+// and the coins stored in a *modules.CoinStorage.
+type AccountAPIService struct {
+	blockStorage   *modules.BlockStorage
+	balanceStorage *modules.BalanceStorage
+	coinStorage    *modules.CoinStorage
+}
+
+// NewAccountAPIService creates a new instance of an AccountAPIService.
+// If coinStorage is nil, /account/coins returns ErrCoinsUnavailable.
+func NewAccountAPIService(
+	blockStorage *modules.BlockStorage,
+	balanceStorage *modules.BalanceStorage,
+	coinStorage *modules.CoinStorage,
+) server.AccountAPIServicer {
+	return &AccountAPIService{
+		blockStorage:   blockStorage,
+		balanceStorage: balanceStorage,
+		coinStorage:    coinStorage,
+	}
+}
+
+// AccountBalance implements the /account/balance endpoint. Balances
+// at historical blocks are read from the historical balances stored
+// by BalanceStorage. If no currencies are provided, the balance of
+// each currency stored for the account is returned.
+func (s *AccountAPIService) AccountBalance(
+	ctx context.Context,
+	request *types.AccountBalanceRequest,
+) (*types.AccountBalanceResponse, *types.Error) {
+	blockIdentifier, rosettaErr := s.blockIdentifier(ctx, request.BlockIdentifier)
+	if rosettaErr != nil {
+		return nil, rosettaErr
+	}
+
+	currencies := request.Currencies
+	if len(currencies) == 0 {
+		var err error
+		currencies, err = s.balanceStorage.GetAccountCurrencies(ctx, request.AccountIdentifier)
+		if err != nil {
+			return nil, storageError(err)
+		}
+	}
+
+	balances := make([]*types.Amount, len(currencies))
+	for i, currency := range currencies {
+		amount, err := s.balanceStorage.GetBalance(
+			ctx,
+			request.AccountIdentifier,
+			currency,
+			blockIdentifier.Index,
+		)
+		switch {
+		case errors.Is(err, storageErrs.ErrAccountMissing):
+			// BalanceStorage only stores accounts
+			// after their balance has changed.
+			amount = &types.Amount{Value: "0", Currency: currency}
+		case err != nil:
+			return nil, storageError(err)
+		}
+
+		balances[i] = amount
+	}
+
+	return &types.AccountBalanceResponse{
+		BlockIdentifier: blockIdentifier,
+		Balances:        balances,
+	}, nil
+}
+
+// AccountCoins implements the /account/coins endpoint. The
+// mempool is not indexed, so IncludeMempool is ignored.
+func (s *AccountAPIService) AccountCoins(
+	ctx context.Context,
+	request *types.AccountCoinsRequest,
+) (*types.AccountCoinsResponse, *types.Error) {
+	if s.coinStorage == nil {
+		return nil, ErrCoinsUnavailable
+	}
+
+	coins, blockIdentifier, err := s.coinStorage.GetCoins(ctx, request.AccountIdentifier)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	if len(request.Currencies) > 0 {
+		currencies := map[string]struct{}{}
+		for _, currency := range request.Currencies {
+			currencies[types.Hash(currency)] = struct{}{}
+		}
+
+		filtered := []*types.Coin{}
+		for _, coin := range coins {
+			if _, ok := currencies[types.Hash(coin.Amount.Currency)]; ok {
+				filtered = append(filtered, coin)
+			}
+		}
+		coins = filtered
+	}
+
+	// GetCoins does not return coins in a deterministic order.
+	sort.Slice(coins, func(i, j int) bool {
+		return coins[i].CoinIdentifier.Identifier < coins[j].CoinIdentifier.Identifier
+	})
+
+	return &types.AccountCoinsResponse{
+		BlockIdentifier: blockIdentifier,
+		Coins:           coins,
+	}, nil
+}
+
+// blockIdentifier returns the canonical *types.BlockIdentifier
+// of a *types.PartialBlockIdentifier (or of the head block if
+// none is provided).
+func (s *AccountAPIService) blockIdentifier(
+	ctx context.Context,
+	partial *types.PartialBlockIdentifier,
+) (*types.BlockIdentifier, *types.Error) {
+	if partial == nil || (partial.Hash == nil && partial.Index == nil) {
+		head, err := s.blockStorage.GetHeadBlockIdentifier(ctx)
+		if err != nil {
+			return nil, storageError(err)
+		}
+
+		return head, nil
+	}
+
+	block, err := s.blockStorage.GetBlockLazy(ctx, partial)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	blockIdentifier := block.Block.BlockIdentifier
+	if rosettaErr := canonicalBlock(
+		ctx,
+		s.blockStorage,
+		partial,
+		blockIdentifier,
+	); rosettaErr != nil {
+		return nil, rosettaErr
+	}
+
+	return blockIdentifier, nil
+}
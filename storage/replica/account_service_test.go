@@ -0,0 +1,212 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replica
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/dominant-strategies/mesh-sdk-go/asserter"
+	mocks "github.com/dominant-strategies/mesh-sdk-go/mocks/storage/modules"
+	"github.com/dominant-strategies/mesh-sdk-go/parser"
+	"github.com/dominant-strategies/mesh-sdk-go/storage/modules"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+func TestAccountAPIService(t *testing.T) {
+	ctx := context.Background()
+	db, blockStorage, cleanup := newTestBlockStorage(ctx, t)
+	defer cleanup()
+
+	a, err := asserter.NewClientWithOptions(
+		&types.NetworkIdentifier{
+			Blockchain: "bitcoin",
+			Network:    "mainnet",
+		},
+		block0.BlockIdentifier,
+		[]string{"Transfer"},
+		[]*types.OperationStatus{
+			{
+				Status:     "Success",
+				Successful: true,
+			},
+		},
+		[]*types.Error{},
+		nil,
+		&asserter.Validations{
+			Enabled: false,
+		},
+	)
+	assert.NoError(t, err)
+
+	balanceHelper := &mocks.BalanceStorageHelper{}
+	balanceHelper.On("Asserter").Return(a)
+	balanceHelper.On("ExemptFunc").Return(parser.ExemptOperation(
+		func(*types.Operation) bool { return false },
+	))
+	balanceHelper.On("BalanceExemptions").Return([]*types.BalanceExemption{})
+	balanceHandler := &mocks.BalanceStorageHandler{}
+	balanceHandler.On("AccountsSeen", ctx, mock.Anything, mock.Anything).Return(nil)
+
+	balanceStorage := modules.NewBalanceStorage(db)
+	balanceStorage.Initialize(balanceHelper, balanceHandler)
+
+	// The account has a balance of 100 at block 0 and
+	// 150 after block 1.
+	dbTx := db.Transaction(ctx)
+	assert.NoError(t, balanceStorage.SetBalance(
+		ctx,
+		dbTx,
+		account,
+		&types.Amount{Value: "100", Currency: currency},
+		block0.BlockIdentifier,
+	))
+	_, err = balanceStorage.UpdateBalance(
+		ctx,
+		dbTx,
+		&parser.BalanceChange{
+			Account:    account,
+			Currency:   currency,
+			Block:      block1.BlockIdentifier,
+			Difference: "50",
+		},
+		block0.BlockIdentifier,
+	)
+	assert.NoError(t, err)
+	assert.NoError(t, dbTx.Commit(ctx))
+
+	coinHelper := &mocks.CoinStorageHelper{}
+	coinHelper.On("CurrentBlockIdentifier", ctx, mock.Anything).Return(
+		block2.BlockIdentifier,
+		nil,
+	)
+	coinStorage := modules.NewCoinStorage(db, coinHelper, a)
+	otherCurrency := &types.Currency{Symbol: "ETH", Decimals: 18}
+	coins := []*types.Coin{
+		{
+			CoinIdentifier: &types.CoinIdentifier{Identifier: "coin 2"},
+			Amount:         &types.Amount{Value: "20", Currency: currency},
+		},
+		{
+			CoinIdentifier: &types.CoinIdentifier{Identifier: "coin 1"},
+			Amount:         &types.Amount{Value: "10", Currency: currency},
+		},
+		{
+			CoinIdentifier: &types.CoinIdentifier{Identifier: "coin 3"},
+			Amount:         &types.Amount{Value: "30", Currency: otherCurrency},
+		},
+	}
+	accountCoins := []*types.AccountCoin{}
+	for _, coin := range coins {
+		accountCoins = append(accountCoins, &types.AccountCoin{Account: account, Coin: coin})
+	}
+	assert.NoError(t, coinStorage.AddCoins(ctx, accountCoins))
+
+	servicer := NewAccountAPIService(blockStorage, balanceStorage, coinStorage)
+
+	var tests = map[string]struct {
+		request *types.AccountBalanceRequest
+
+		expectedResponse *types.AccountBalanceResponse
+		expectedErr      *types.Error
+	}{
+		"head": {
+			request: &types.AccountBalanceRequest{
+				AccountIdentifier: account,
+			},
+			expectedResponse: &types.AccountBalanceResponse{
+				BlockIdentifier: block2.BlockIdentifier,
+				Balances: []*types.Amount{
+					{Value: "150", Currency: currency},
+				},
+			},
+		},
+		"historical": {
+			request: &types.AccountBalanceRequest{
+				AccountIdentifier: account,
+				BlockIdentifier: &types.PartialBlockIdentifier{
+					Hash: types.String("block 0"),
+				},
+			},
+			expectedResponse: &types.AccountBalanceResponse{
+				BlockIdentifier: block0.BlockIdentifier,
+				Balances: []*types.Amount{
+					{Value: "100", Currency: currency},
+				},
+			},
+		},
+		"unseen currency": {
+			request: &types.AccountBalanceRequest{
+				AccountIdentifier: account,
+				BlockIdentifier: &types.PartialBlockIdentifier{
+					Index: types.Int64(1),
+				},
+				Currencies: []*types.Currency{currency, otherCurrency},
+			},
+			expectedResponse: &types.AccountBalanceResponse{
+				BlockIdentifier: block1.BlockIdentifier,
+				Balances: []*types.Amount{
+					{Value: "150", Currency: currency},
+					{Value: "0", Currency: otherCurrency},
+				},
+			},
+		},
+		"orphaned block": {
+			request: &types.AccountBalanceRequest{
+				AccountIdentifier: account,
+				BlockIdentifier:   types.ConstructPartialBlockIdentifier(orphanBlock.BlockIdentifier),
+			},
+			expectedErr: ErrBlockNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			response, err := servicer.AccountBalance(ctx, test.request)
+			if test.expectedErr != nil {
+				assert.Nil(t, response)
+				assert.Equal(t, test.expectedErr.Code, err.Code)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, test.expectedResponse, response)
+		})
+	}
+
+	t.Run("coins", func(t *testing.T) {
+		response, err := servicer.AccountCoins(ctx, &types.AccountCoinsRequest{
+			AccountIdentifier: account,
+			Currencies:        []*types.Currency{currency},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, &types.AccountCoinsResponse{
+			BlockIdentifier: block2.BlockIdentifier,
+			Coins:           []*types.Coin{coins[1], coins[0]},
+		}, response)
+	})
+
+	t.Run("coins unavailable", func(t *testing.T) {
+		servicer := NewAccountAPIService(blockStorage, balanceStorage, nil)
+		response, err := servicer.AccountCoins(ctx, &types.AccountCoinsRequest{
+			AccountIdentifier: account,
+		})
+		assert.Nil(t, response)
+		assert.Equal(t, ErrCoinsUnavailable, err)
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replica
+
+import (
+	"context"
+
+	"github.com/dominant-strategies/mesh-sdk-go/server"
+	"github.com/dominant-strategies/mesh-sdk-go/storage/modules"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// BlockAPIService implements server.BlockAPIServicer
+// using the blocks stored in a *modules.BlockStorage.
+type BlockAPIService struct {
+	blockStorage *modules.BlockStorage
+}
+
+// NewBlockAPIService creates a new instance of a BlockAPIService.
+func NewBlockAPIService(blockStorage *modules.BlockStorage) server.BlockAPIServicer {
+	return &BlockAPIService{
+		blockStorage: blockStorage,
+	}
+}
+
+// Block implements the /block endpoint.
+func (s *BlockAPIService) Block(
+	ctx context.Context,
+	request *types.BlockRequest,
+) (*types.BlockResponse, *types.Error) {
+	block, err := s.blockStorage.GetBlock(ctx, request.BlockIdentifier)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	if rosettaErr := canonicalBlock(
+		ctx,
+		s.blockStorage,
+		request.BlockIdentifier,
+		block.BlockIdentifier,
+	); rosettaErr != nil {
+		return nil, rosettaErr
+	}
+
+	return &types.BlockResponse{
+		Block: block,
+	}, nil
+}
+
+// BlockTransaction implements the /block/transaction endpoint.
+func (s *BlockAPIService) BlockTransaction(
+	ctx context.Context,
+	request *types.BlockTransactionRequest,
+) (*types.BlockTransactionResponse, *types.Error) {
+	if rosettaErr := canonicalBlock(
+		ctx,
+		s.blockStorage,
+		types.ConstructPartialBlockIdentifier(request.BlockIdentifier),
+		request.BlockIdentifier,
+	); rosettaErr != nil {
+		return nil, rosettaErr
+	}
+
+	transaction, err := s.blockStorage.GetBlockTransaction(
+		ctx,
+		request.BlockIdentifier,
+		request.TransactionIdentifier,
+	)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return &types.BlockTransactionResponse{
+		Transaction: transaction,
+	}, nil
+}
+
+// canonicalBlock returns ErrBlockNotFound if a block requested
+// by hash is not in the canonical chain (BlockStorage stores
+// blocks that are seen but never added or later removed) or
+// does not match the requested index. Blocks requested only by
+// index (or the head block) are always canonical.
+func canonicalBlock(
+	ctx context.Context,
+	blockStorage *modules.BlockStorage,
+	requested *types.PartialBlockIdentifier,
+	blockIdentifier *types.BlockIdentifier,
+) *types.Error {
+	if requested == nil || requested.Hash == nil {
+		return nil
+	}
+
+	if requested.Index != nil && *requested.Index != blockIdentifier.Index {
+		return ErrBlockNotFound
+	}
+
+	canonical, err := blockStorage.GetBlockLazy(
+		ctx,
+		&types.PartialBlockIdentifier{Index: &blockIdentifier.Index},
+	)
+	if err != nil {
+		return storageError(err)
+	}
+
+	if canonical.Block.BlockIdentifier.Hash != blockIdentifier.Hash {
+		return ErrBlockNotFound
+	}
+
+	return nil
+}
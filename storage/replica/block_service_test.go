@@ -0,0 +1,216 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replica
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/storage/database"
+	"github.com/dominant-strategies/mesh-sdk-go/storage/modules"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+	"github.com/dominant-strategies/mesh-sdk-go/utils"
+)
+
+var (
+	account = &types.AccountIdentifier{
+		Address: "addr1",
+	}
+
+	currency = &types.Currency{
+		Symbol:   "BTC",
+		Decimals: 8,
+	}
+
+	transaction = &types.Transaction{
+		TransactionIdentifier: &types.TransactionIdentifier{
+			Hash: "tx 1",
+		},
+		Operations: []*types.Operation{
+			{
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: 0,
+				},
+				Type:    "Transfer",
+				Status:  types.String("Success"),
+				Account: account,
+				Amount: &types.Amount{
+					Value:    "50",
+					Currency: currency,
+				},
+			},
+		},
+	}
+
+	block0 = &types.Block{
+		BlockIdentifier: &types.BlockIdentifier{
+			Hash:  "block 0",
+			Index: 0,
+		},
+		ParentBlockIdentifier: &types.BlockIdentifier{
+			Hash:  "block 0",
+			Index: 0,
+		},
+	}
+
+	block1 = &types.Block{
+		BlockIdentifier: &types.BlockIdentifier{
+			Hash:  "block 1",
+			Index: 1,
+		},
+		ParentBlockIdentifier: block0.BlockIdentifier,
+		Transactions:          []*types.Transaction{transaction},
+	}
+
+	block2 = &types.Block{
+		BlockIdentifier: &types.BlockIdentifier{
+			Hash:  "block 2",
+			Index: 2,
+		},
+		ParentBlockIdentifier: block1.BlockIdentifier,
+	}
+
+	// orphanBlock is seen but never added to BlockStorage.
+	orphanBlock = &types.Block{
+		BlockIdentifier: &types.BlockIdentifier{
+			Hash:  "orphan 2",
+			Index: 2,
+		},
+		ParentBlockIdentifier: block1.BlockIdentifier,
+	}
+)
+
+func newTestBlockStorage(
+	ctx context.Context,
+	t *testing.T,
+) (database.Database, *modules.BlockStorage, func()) {
+	dir, err := utils.CreateTempDir()
+	assert.NoError(t, err)
+
+	db, err := database.NewBadgerDatabase(
+		ctx,
+		dir,
+		database.WithIndexCacheSize(database.TinyIndexCacheSize),
+	)
+	assert.NoError(t, err)
+
+	blockStorage := modules.NewBlockStorage(
+		db,
+		1,
+		modules.WithTransactionIndexes(modules.AccountIndex),
+	)
+	for _, block := range []*types.Block{block0, block1, block2} {
+		assert.NoError(t, blockStorage.SeeBlock(ctx, block))
+		assert.NoError(t, blockStorage.AddBlock(ctx, block))
+	}
+	assert.NoError(t, blockStorage.SeeBlock(ctx, orphanBlock))
+
+	return db, blockStorage, func() {
+		db.Close(ctx)
+		utils.RemoveTempDir(dir)
+	}
+}
+
+func TestBlockAPIService(t *testing.T) {
+	ctx := context.Background()
+	_, blockStorage, cleanup := newTestBlockStorage(ctx, t)
+	defer cleanup()
+
+	servicer := NewBlockAPIService(blockStorage)
+
+	var tests = map[string]struct {
+		blockIdentifier *types.PartialBlockIdentifier
+
+		expectedBlock *types.Block
+		expectedErr   *types.Error
+	}{
+		"head": {
+			blockIdentifier: &types.PartialBlockIdentifier{},
+			expectedBlock:   block2,
+		},
+		"index": {
+			blockIdentifier: &types.PartialBlockIdentifier{Index: types.Int64(1)},
+			expectedBlock:   block1,
+		},
+		"hash": {
+			blockIdentifier: &types.PartialBlockIdentifier{Hash: types.String("block 1")},
+			expectedBlock:   block1,
+		},
+		"hash and index": {
+			blockIdentifier: types.ConstructPartialBlockIdentifier(block0.BlockIdentifier),
+			expectedBlock:   block0,
+		},
+		"mismatched hash and index": {
+			blockIdentifier: &types.PartialBlockIdentifier{
+				Hash:  types.String("block 1"),
+				Index: types.Int64(0),
+			},
+			expectedErr: ErrBlockNotFound,
+		},
+		"orphaned block": {
+			blockIdentifier: types.ConstructPartialBlockIdentifier(orphanBlock.BlockIdentifier),
+			expectedErr:     ErrBlockNotFound,
+		},
+		"missing block": {
+			blockIdentifier: &types.PartialBlockIdentifier{Index: types.Int64(3)},
+			expectedErr:     ErrBlockNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			response, err := servicer.Block(ctx, &types.BlockRequest{
+				BlockIdentifier: test.blockIdentifier,
+			})
+			if test.expectedErr != nil {
+				assert.Nil(t, response)
+				assert.Equal(t, test.expectedErr.Code, err.Code)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, types.Hash(test.expectedBlock), types.Hash(response.Block))
+		})
+	}
+
+	t.Run("block transaction", func(t *testing.T) {
+		response, err := servicer.BlockTransaction(ctx, &types.BlockTransactionRequest{
+			BlockIdentifier:       block1.BlockIdentifier,
+			TransactionIdentifier: transaction.TransactionIdentifier,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, types.Hash(transaction), types.Hash(response.Transaction))
+	})
+
+	t.Run("missing block transaction", func(t *testing.T) {
+		response, err := servicer.BlockTransaction(ctx, &types.BlockTransactionRequest{
+			BlockIdentifier:       block2.BlockIdentifier,
+			TransactionIdentifier: transaction.TransactionIdentifier,
+		})
+		assert.Nil(t, response)
+		assert.Equal(t, ErrTransactionNotFound.Code, err.Code)
+	})
+
+	t.Run("orphaned block transaction", func(t *testing.T) {
+		response, err := servicer.BlockTransaction(ctx, &types.BlockTransactionRequest{
+			BlockIdentifier:       orphanBlock.BlockIdentifier,
+			TransactionIdentifier: transaction.TransactionIdentifier,
+		})
+		assert.Nil(t, response)
+		assert.Equal(t, ErrBlockNotFound.Code, err.Code)
+	})
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replica
+
+import (
+	"errors"
+
+	storageErrs "github.com/dominant-strategies/mesh-sdk-go/storage/errors"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+var (
+	// ErrBlockNotFound is returned when a block is not
+	// stored (or is not in the canonical chain).
+	ErrBlockNotFound = &types.Error{
+		Code:    1100,
+		Message: "block not found",
+	}
+
+	// ErrTransactionNotFound is returned when a transaction
+	// is not stored.
+	ErrTransactionNotFound = &types.Error{
+		Code:    1101,
+		Message: "transaction not found",
+	}
+
+	// ErrPruned is returned when the requested data
+	// has been pruned from storage.
+	ErrPruned = &types.Error{
+		Code:    1102,
+		Message: "data pruned",
+	}
+
+	// ErrStorage is returned when storage cannot be
+	// read. The cause is included in the error details.
+	ErrStorage = &types.Error{
+		Code:      1103,
+		Message:   "unable to read storage",
+		Retriable: true,
+	}
+
+	// ErrCoinsUnavailable is returned by /account/coins
+	// when the AccountAPIService has no CoinStorage.
+	ErrCoinsUnavailable = &types.Error{
+		Code:    1104,
+		Message: "coins are not indexed",
+	}
+
+	// ErrSearchUnsupported is returned by /search/transactions
+	// when the request uses a condition that is not indexed.
+	ErrSearchUnsupported = &types.Error{
+		Code:    1105,
+		Message: "search condition is not supported",
+	}
+
+	// Errors contains all errors that could be returned
+	// by the services in this package. It should be included
+	// in the /network/options response.
+	Errors = []*types.Error{
+		ErrBlockNotFound,
+		ErrTransactionNotFound,
+		ErrPruned,
+		ErrStorage,
+		ErrCoinsUnavailable,
+		ErrSearchUnsupported,
+	}
+)
+
+// wrapErr adds the context of err to a copy of
+// the provided *types.Error.
+func wrapErr(rosettaErr *types.Error, err error) *types.Error {
+	newErr := *rosettaErr
+	if err != nil {
+		newErr.Details = map[string]interface{}{
+			"context": err.Error(),
+		}
+	}
+
+	return &newErr
+}
+
+// storageError converts an error returned by
+// storage/modules into a *types.Error.
+func storageError(err error) *types.Error {
+	switch {
+	case errors.Is(err, storageErrs.ErrBlockNotFound),
+		errors.Is(err, storageErrs.ErrHeadBlockNotFound):
+		return wrapErr(ErrBlockNotFound, err)
+	case errors.Is(err, storageErrs.ErrTransactionNotFound):
+		return wrapErr(ErrTransactionNotFound, err)
+	case errors.Is(err, storageErrs.ErrCannotAccessPrunedData),
+		errors.Is(err, storageErrs.ErrBalancePruned):
+		return wrapErr(ErrPruned, err)
+	case errors.Is(err, storageErrs.ErrTransactionIndexMissing),
+		errors.Is(err, storageErrs.ErrTransactionIndexIncomplete),
+		errors.Is(err, storageErrs.ErrSearchConditionUnsupported),
+		errors.Is(err, storageErrs.ErrSearchConditionsMissing):
+		return wrapErr(ErrSearchUnsupported, err)
+	default:
+		return wrapErr(ErrStorage, err)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replica
+
+import (
+	"context"
+
+	"github.com/dominant-strategies/mesh-sdk-go/server"
+	"github.com/dominant-strategies/mesh-sdk-go/storage/modules"
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+// SearchAPIService implements server.SearchAPIServicer
+// using the transactions indexed by a *modules.BlockStorage.
+type SearchAPIService struct {
+	blockStorage *modules.BlockStorage
+}
+
+// NewSearchAPIService creates a new instance of a SearchAPIService.
+// Conditions other than transaction_identifier require the matching
+// index to be enabled with modules.WithTransactionIndexes.
+func NewSearchAPIService(blockStorage *modules.BlockStorage) server.SearchAPIServicer {
+	return &SearchAPIService{
+		blockStorage: blockStorage,
+	}
+}
+
+// SearchTransactions implements the /search/transactions endpoint.
+// Requests with a condition that is not indexed (or the success
+// condition) return ErrSearchUnsupported, as do requests using an
+// index that has not been backfilled.
+func (s *SearchAPIService) SearchTransactions(
+	ctx context.Context,
+	request *types.SearchTransactionsRequest,
+) (*types.SearchTransactionsResponse, *types.Error) {
+	response, err := s.blockStorage.SearchTransactions(ctx, request)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 Coinbase, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replica
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dominant-strategies/mesh-sdk-go/types"
+)
+
+func TestSearchAPIService(t *testing.T) {
+	ctx := context.Background()
+	_, blockStorage, cleanup := newTestBlockStorage(ctx, t)
+	defer cleanup()
+
+	servicer := NewSearchAPIService(blockStorage)
+
+	var tests = map[string]struct {
+		request *types.SearchTransactionsRequest
+
+		expectedResponse *types.SearchTransactionsResponse
+		expectedErr      *types.Error
+	}{
+		"transaction identifier": {
+			request: &types.SearchTransactionsRequest{
+				TransactionIdentifier: transaction.TransactionIdentifier,
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{
+					{
+						BlockIdentifier: block1.BlockIdentifier,
+						Transaction:     transaction,
+					},
+				},
+				TotalCount: 1,
+			},
+		},
+		"before max block": {
+			request: &types.SearchTransactionsRequest{
+				TransactionIdentifier: transaction.TransactionIdentifier,
+				MaxBlock:              types.Int64(0),
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{},
+			},
+		},
+		"past offset": {
+			request: &types.SearchTransactionsRequest{
+				TransactionIdentifier: transaction.TransactionIdentifier,
+				Offset:                types.Int64(1),
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{},
+				TotalCount:   1,
+			},
+		},
+		"missing transaction": {
+			request: &types.SearchTransactionsRequest{
+				TransactionIdentifier: &types.TransactionIdentifier{Hash: "tx 2"},
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{},
+			},
+		},
+		"account": {
+			request: &types.SearchTransactionsRequest{
+				AccountIdentifier: account,
+			},
+			expectedResponse: &types.SearchTransactionsResponse{
+				Transactions: []*types.BlockTransaction{
+					{
+						BlockIdentifier: block1.BlockIdentifier,
+						Transaction:     transaction,
+					},
+				},
+				TotalCount: 1,
+			},
+		},
+		"unindexed condition": {
+			request: &types.SearchTransactionsRequest{
+				Type: types.String("Transfer"),
+			},
+			expectedErr: ErrSearchUnsupported,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			response, err := servicer.SearchTransactions(ctx, test.request)
+			if test.expectedErr != nil {
+				assert.Nil(t, response)
+				assert.Equal(t, test.expectedErr.Code, err.Code)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, types.Hash(test.expectedResponse), types.Hash(response))
+		})
+	}
+}